@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/gbl08ma/anko/core"
@@ -79,7 +80,13 @@ func runNonInteractive() int {
 		source = string(sourceBytes)
 	}
 
-	_, err := vm.Execute(e, nil, source)
+	options := &vm.Options{}
+	if file != "" {
+		// modules are searched for next to the script
+		options.ModuleLoader = vm.NewModuleLoader(filepath.Dir(file))
+	}
+
+	_, err := vm.Execute(e, options, source)
 	if err != nil {
 		fmt.Println("Execute error:", err)
 		return 4
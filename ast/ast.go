@@ -21,6 +21,8 @@ const (
 	TypeMap
 	// TypeChan chan type
 	TypeChan
+	// TypeStructType struct type
+	TypeStructType
 )
 
 // TypeStruct is the type and sub-types
@@ -31,4 +33,8 @@ type TypeStruct struct {
 	Dimensions int
 	SubType    *TypeStruct
 	Key        *TypeStruct
+
+	StructNames []string
+	StructTypes []*TypeStruct
+	StructTags  []string
 }
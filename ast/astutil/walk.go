@@ -52,6 +52,7 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		}
 	case *ast.BreakStmt:
 	case *ast.ContinueStmt:
+	case *ast.FallthroughStmt:
 	case *ast.LetMapItemStmt:
 		if err := walkExpr(stmt.RHS, f); err != nil {
 			return err
@@ -63,6 +64,8 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		return walkExpr(stmt.Expr, f)
 	case *ast.VarStmt:
 		return walkExprs(stmt.Exprs, f)
+	case *ast.ConstStmt:
+		return walkExprs(stmt.Exprs, f)
 	case *ast.LetsStmt:
 		if err := walkExprs(stmt.RHSS, f); err != nil {
 			return err
@@ -88,9 +91,16 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		if err := walkStmt(stmt.Catch, f); err != nil {
 			return err
 		}
+		if err := walkStmts(stmt.Catches, f); err != nil {
+			return err
+		}
 		if err := walkStmt(stmt.Finally, f); err != nil {
 			return err
 		}
+	case *ast.CatchStmt:
+		if err := walkStmt(stmt.Stmt, f); err != nil {
+			return err
+		}
 	case *ast.LoopStmt:
 		if err := walkExpr(stmt.Expr, f); err != nil {
 			return err
@@ -139,8 +149,39 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		if err := walkStmt(stmt.Default, f); err != nil {
 			return err
 		}
+	case *ast.TypeSwitchStmt:
+		if err := walkExpr(stmt.Expr, f); err != nil {
+			return err
+		}
+		for _, typeSwitchCaseStmt := range stmt.Cases {
+			caseStmt := typeSwitchCaseStmt.(*ast.TypeSwitchCaseStmt)
+			if err := walkStmt(caseStmt.Stmt, f); err != nil {
+				return err
+			}
+		}
+		if err := walkStmt(stmt.Default, f); err != nil {
+			return err
+		}
+	case *ast.SelectStmt:
+		for _, selectCaseStmt := range stmt.Cases {
+			caseStmt := selectCaseStmt.(*ast.SelectCaseStmt)
+			if err := walkExprs(caseStmt.LHSS, f); err != nil {
+				return err
+			}
+			if err := walkExpr(caseStmt.Expr, f); err != nil {
+				return err
+			}
+			if err := walkStmt(caseStmt.Stmt, f); err != nil {
+				return err
+			}
+		}
+		if err := walkStmt(stmt.Default, f); err != nil {
+			return err
+		}
 	case *ast.GoroutineStmt:
 		return walkExpr(stmt.Expr, f)
+	case *ast.DeferStmt:
+		return walkExpr(stmt.Expr, f)
 	default:
 		return fmt.Errorf("unknown statement %v", reflect.TypeOf(stmt))
 	}
@@ -176,6 +217,8 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 			return err
 		}
 		return walkExpr(expr.End, f)
+	case *ast.InterpolatedStringExpr:
+		return walkExprs(expr.Exprs, f)
 	case *ast.ArrayExpr:
 		return walkExprs(expr.Exprs, f)
 	case *ast.MapExpr:
@@ -187,6 +230,19 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 				return err
 			}
 		}
+	case *ast.ComprehensionExpr:
+		if err := walkExpr(expr.Key, f); err != nil {
+			return err
+		}
+		if err := walkExpr(expr.Value, f); err != nil {
+			return err
+		}
+		if err := walkExpr(expr.Range, f); err != nil {
+			return err
+		}
+		return walkExpr(expr.Cond, f)
+	case *ast.SpreadExpr:
+		return walkExpr(expr.Expr, f)
 	case *ast.DerefExpr:
 		return walkExpr(expr.Expr, f)
 	case *ast.AddrExpr:
@@ -206,7 +262,7 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 		if err := walkExpr(expr.Expr, f); err != nil {
 			return err
 		}
-		return walkExpr(&ast.CallExpr{Func: reflect.Value{}, SubExprs: expr.SubExprs, VarArg: expr.VarArg, Go: expr.Go}, f)
+		return walkExpr(&ast.CallExpr{Func: reflect.Value{}, SubExprs: expr.SubExprs, VarArg: expr.VarArg, Go: expr.Go, Defer: expr.Defer}, f)
 	case *ast.CallExpr:
 		return walkExprs(expr.SubExprs, f)
 	case *ast.TernaryOpExpr:
@@ -219,6 +275,8 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 		return walkExpr(expr.RHS, f)
 	case *ast.ImportExpr:
 		return walkExpr(expr.Name, f)
+	case *ast.TypeAssertExpr:
+		return walkExpr(expr.Expr, f)
 	case *ast.MakeExpr:
 		if err := walkExpr(expr.LenExpr, f); err != nil {
 			return err
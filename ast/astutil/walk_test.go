@@ -12,6 +12,7 @@ import (
 const (
 	goodSrc string = `
 var fmt = import("fmt")
+var util = import "./lib/util.ank"
 
 a = "1"
 b = 2
@@ -23,6 +24,15 @@ func testA(arg1, arg2, arg3) {
 
 func Main(arg1) {
 	fmt.Println("enter Main")
+	const MAX = 3
+	count := 0
+	big := 123n + 12.30d
+	spread := [[1]..., {...{"a": 2}}]
+	fmt.Println(spread..., 3)
+	fmt.Println(m?.foo?.["bar"]?.())
+	squares := {x: x * x for x in [y + 1 for y in spread if y > 0]}
+	double := x => x * 2
+	less := (a, b) => { return double(a) < b }
 	b = testA(1, 2, 3) + Tester()
 
 	if b == 0 {
@@ -48,6 +58,16 @@ func Main(arg1) {
 		fmt.Println(e)
 	}
 
+	try {
+		throw {"code": 1}
+	} catch (e: string) {
+		fmt.Println(e)
+	} catch (e) {
+		fmt.Println(e.Value)
+	} finally {
+		fmt.Println("done")
+	}
+
 	for n = 0; n < 3; n++ {
 		if n < 2 {
 			continue
@@ -55,6 +75,31 @@ func Main(arg1) {
 		fmt.Println(n)
 	}
 
+	outer: for n in [1, 2, 3] {
+		for m in [1, 2, 3] {
+			if m == n {
+				continue outer
+			}
+		}
+	}
+
+	switch {
+	case arg1 > 1:
+		fmt.Println("arg0 is more than 1")
+		fallthrough
+	case arg1 > 0:
+		fmt.Println("arg0 is more than 0")
+	}
+
+	switch v := a.(type) {
+	case string, []interface:
+		fmt.Println(len(v))
+	case nil:
+		fmt.Println("nil")
+	default:
+		fmt.Println(v.(int64))
+	}
+
 	for n in [1, 2, 3, 4, 5] {
 		fmt.Println(n)
 		if n > 3 {
@@ -73,8 +118,10 @@ func Main(arg1) {
 		fmt.Println("foo is zoo")
 	}
 	fmt.Println(a["foo"] == "zoo" ? "zoo" : "baz")
+	fmt.Println("foo is ${a["foo"]}")
 
 	c = make(chan int64)
+	defer println("done")
 
 	go func() {
 	  c <- 1
@@ -86,6 +133,13 @@ func Main(arg1) {
 	println(<-c)
 	println(<-c)
 
+	select {
+	case v = <-c:
+		fmt.Println(v)
+	case c <- 4:
+	default:
+	}
+
 	v = make([]int, 3)
 	fmt.Println("sizeof v is ", len(v))
 
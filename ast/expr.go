@@ -26,6 +26,14 @@ type LiteralExpr struct {
 	Literal reflect.Value
 }
 
+// InterpolatedStringExpr provide string with embedded expressions. ex: "a ${b} c".
+// Strings has one more element than Exprs, they are joined alternately.
+type InterpolatedStringExpr struct {
+	ExprImpl
+	Strings []string
+	Exprs   []Expr
+}
+
 // ArrayExpr provide Array expression.
 type ArrayExpr struct {
 	ExprImpl
@@ -33,6 +41,13 @@ type ArrayExpr struct {
 	TypeData *TypeStruct
 }
 
+// SpreadExpr provide spread expression, expanding the items of Expr. ex: [a..., b].
+// In a MapExpr a spread is stored as a key with a nil value. ex: {...a, "b": 1}.
+type SpreadExpr struct {
+	ExprImpl
+	Expr Expr
+}
+
 // MapExpr provide Map expression.
 type MapExpr struct {
 	ExprImpl
@@ -41,6 +56,18 @@ type MapExpr struct {
 	TypeData *TypeStruct
 }
 
+// ComprehensionExpr provide list and map comprehension expression.
+// ex: [x * 2 for x in a if x > 0] or {k: v for k, v in m}.
+// Key is nil for a list comprehension, Cond is nil when there is no if.
+type ComprehensionExpr struct {
+	ExprImpl
+	Key   Expr
+	Value Expr
+	Vars  []string
+	Range Expr
+	Cond  Expr
+}
+
 // IdentExpr provide identity expression.
 type IdentExpr struct {
 	ExprImpl
@@ -95,29 +122,37 @@ type CallExpr struct {
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Defer    bool
 }
 
 // AnonCallExpr provide anonymous calling expression. ex: func(){}().
+// Optional is true when it is part of an optional chain. ex: f?.() or a?.b().
 type AnonCallExpr struct {
 	ExprImpl
 	Expr     Expr
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Defer    bool
+	Optional bool
 }
 
 // MemberExpr provide expression to refer member.
+// Optional is true when it is part of an optional chain. ex: a?.b or a?.b.c.
 type MemberExpr struct {
 	ExprImpl
-	Expr Expr
-	Name string
+	Expr     Expr
+	Name     string
+	Optional bool
 }
 
 // ItemExpr provide expression to refer Map/Array item.
+// Optional is true when it is part of an optional chain. ex: a?.[b] or a?.b[c].
 type ItemExpr struct {
 	ExprImpl
-	Item  Expr
-	Index Expr
+	Item     Expr
+	Index    Expr
+	Optional bool
 }
 
 // SliceExpr provide expression to refer slice of Array.
@@ -130,12 +165,14 @@ type SliceExpr struct {
 }
 
 // FuncExpr provide function expression.
+// For methods Receiver is the receiver type and the first of Params is the receiver name.
 type FuncExpr struct {
 	ExprImpl
-	Name   string
-	Stmt   Stmt
-	Params []string
-	VarArg bool
+	Name     string
+	Stmt     Stmt
+	Params   []string
+	VarArg   bool
+	Receiver *TypeStruct
 }
 
 // LetsExpr provide multiple expression of let.
@@ -166,11 +203,22 @@ type MakeExpr struct {
 	CapExpr  Expr
 }
 
+// TypeAssertExpr provide type assertion expression. ex: a.(string).
+// When Ok is true the result is the value and whether the assertion succeeded.
+type TypeAssertExpr struct {
+	ExprImpl
+	Expr     Expr
+	TypeData *TypeStruct
+	Ok       bool
+}
+
 // MakeTypeExpr provide expression to make type.
+// Type is an expression whose type is used, TypeData is used instead when not nil.
 type MakeTypeExpr struct {
 	ExprImpl
-	Name string
-	Type Expr
+	Name     string
+	Type     Expr
+	TypeData *TypeStruct
 }
 
 // LenExpr provide expression to get length of array, map, etc.
@@ -32,20 +32,32 @@ type IfStmt struct {
 }
 
 // TryStmt provide "try/catch/finally" statement.
+// Catches is used instead of Var and Catch when the catch clauses have parentheses.
 type TryStmt struct {
 	StmtImpl
 	Try     Stmt
 	Var     string
 	Catch   Stmt
+	Catches []Stmt // This is array of CatchStmt
 	Finally Stmt
 }
 
+// CatchStmt provide "catch (e: type)" clause of try statement.
+// A nil Type catches everything.
+type CatchStmt struct {
+	StmtImpl
+	Var  string
+	Type *TypeStruct
+	Stmt Stmt
+}
+
 // ForStmt provide "for in" expression statement.
 type ForStmt struct {
 	StmtImpl
 	Vars  []string
 	Value Expr
 	Stmt  Stmt
+	Label string
 }
 
 // CForStmt provide C-style "for (;;)" expression statement.
@@ -55,23 +67,27 @@ type CForStmt struct {
 	Expr2 Expr
 	Expr3 Expr
 	Stmt  Stmt
+	Label string
 }
 
 // LoopStmt provide "for expr" expression statement.
 type LoopStmt struct {
 	StmtImpl
-	Expr Expr
-	Stmt Stmt
+	Expr  Expr
+	Stmt  Stmt
+	Label string
 }
 
 // BreakStmt provide "break" expression statement.
 type BreakStmt struct {
 	StmtImpl
+	Label string
 }
 
 // ContinueStmt provide "continue" expression statement.
 type ContinueStmt struct {
 	StmtImpl
+	Label string
 }
 
 // ReturnStmt provide "return" expression statement.
@@ -87,13 +103,20 @@ type ThrowStmt struct {
 }
 
 // ModuleStmt provide "module" expression statement.
+// Symbols starting with an underscore are private to the module.
 type ModuleStmt struct {
 	StmtImpl
 	Name string
 	Stmt Stmt
 }
 
+// FallthroughStmt provide fallthrough statement.
+type FallthroughStmt struct {
+	StmtImpl
+}
+
 // SwitchStmt provide switch statement.
+// When Expr is nil the cases are evaluated as booleans.
 type SwitchStmt struct {
 	StmtImpl
 	Expr    Expr
@@ -108,6 +131,41 @@ type SwitchCaseStmt struct {
 	Stmt  Stmt
 }
 
+// TypeSwitchStmt provide type switch statement.
+// Var is the optional variable the value is assigned to in the cases.
+type TypeSwitchStmt struct {
+	StmtImpl
+	Var     string
+	Expr    Expr
+	Cases   []Stmt
+	Default Stmt
+}
+
+// TypeSwitchCaseStmt provide type switch case statement.
+// A nil in Types is for the nil case.
+type TypeSwitchCaseStmt struct {
+	StmtImpl
+	Types []*TypeStruct
+	Stmt  Stmt
+}
+
+// SelectStmt provide select statement.
+type SelectStmt struct {
+	StmtImpl
+	Cases   []Stmt
+	Default Stmt
+}
+
+// SelectCaseStmt provide select case statement.
+// Expr is the ChanExpr to send or receive on.
+// LHSS are the optional expressions the received value and ok are assigned to.
+type SelectCaseStmt struct {
+	StmtImpl
+	LHSS []Expr
+	Expr Expr
+	Stmt Stmt
+}
+
 // VarStmt provide statement to let variables in current scope.
 type VarStmt struct {
 	StmtImpl
@@ -115,6 +173,13 @@ type VarStmt struct {
 	Exprs []Expr
 }
 
+// ConstStmt provide statement to define constants in current scope.
+type ConstStmt struct {
+	StmtImpl
+	Names []string
+	Exprs []Expr
+}
+
 // LetsStmt provide multiple statement of let.
 type LetsStmt struct {
 	StmtImpl
@@ -135,6 +200,12 @@ type GoroutineStmt struct {
 	Expr Expr
 }
 
+// DeferStmt provide statement of defer.
+type DeferStmt struct {
+	StmtImpl
+	Expr Expr
+}
+
 // DeleteStmt provides statement of delete.
 type DeleteStmt struct {
 	ExprImpl
@@ -47,6 +47,10 @@ func TestToX(t *testing.T) {
 		{Script: `toString({"foo": "bar"})`, RunOutput: "map[foo:bar]"},
 		{Script: `toString([true,nil])`, RunOutput: "[true <nil>]"},
 		{Script: `toString(toByteSlice("foo"))`, RunOutput: "foo"},
+		{Script: `toString(12345678901234567890n)`, RunOutput: "12345678901234567890"},
+		{Script: `toString(1.50d)`, RunOutput: "1.5"},
+		{Script: `toString(1d/3d)`, RunOutput: "0.3333333333333333333333333333333333"},
+		{Script: `a = 1d/3d; toString(a) == "${a}"`, RunOutput: true},
 		{Script: `toInt(nil)`, RunOutput: int64(0)},
 		{Script: `toInt(-2)`, RunOutput: int64(-2)},
 		{Script: `toInt(-1.4)`, RunOutput: int64(-1)},
@@ -64,6 +68,12 @@ func TestToX(t *testing.T) {
 		{Script: `toInt(false)`, RunOutput: int64(0)},
 		{Script: `toInt({})`, RunOutput: int64(0)},
 		{Script: `toInt([])`, RunOutput: int64(0)},
+		{Script: `toInt(5n)`, RunOutput: int64(5)},
+		{Script: `toInt(-5n)`, RunOutput: int64(-5)},
+		{Script: `toInt(1.9d)`, RunOutput: int64(1)},
+		{Script: `toInt(-1.9d)`, RunOutput: int64(-1)},
+		{Script: `toInt(12345678901234567890n)`, RunError: fmt.Errorf("integer 12345678901234567890 overflows int64")},
+		{Script: `toInt(12345678901234567890.5d)`, RunError: fmt.Errorf("integer 12345678901234567890 overflows int64")},
 		{Script: `toFloat(nil)`, RunOutput: float64(0.0)},
 		{Script: `toFloat(-2)`, RunOutput: float64(-2.0)},
 		{Script: `toFloat(-1.4)`, RunOutput: float64(-1.4)},
@@ -81,6 +91,9 @@ func TestToX(t *testing.T) {
 		{Script: `toFloat(false)`, RunOutput: float64(0.0)},
 		{Script: `toFloat({})`, RunOutput: float64(0.0)},
 		{Script: `toFloat([])`, RunOutput: float64(0.0)},
+		{Script: `toFloat(5n)`, RunOutput: float64(5.0)},
+		{Script: `toFloat(1.5d)`, RunOutput: float64(1.5)},
+		{Script: `toFloat(-1d/4d)`, RunOutput: float64(-0.25)},
 		{Script: `toChar(0x1F431)`, RunOutput: "🐱"},
 		{Script: `toChar(0)`, RunOutput: "\x00"},
 		{Script: `toRune("")`, RunOutput: rune(0)},
@@ -2,12 +2,14 @@ package core
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gbl08ma/anko/env"
+	"github.com/gbl08ma/anko/vm"
 )
 
 // ImportToX adds all the toX to the env given
@@ -39,13 +41,28 @@ func ImportToX(e *env.Env) {
 	})
 
 	e.Define("toString", func(v interface{}) string {
-		if b, ok := v.([]byte); ok {
-			return string(b)
+		switch n := v.(type) {
+		case []byte:
+			return string(n)
+		case *big.Int:
+			if n != nil {
+				return n.String()
+			}
+		case *big.Rat:
+			if n != nil {
+				return vm.DecimalString(n)
+			}
 		}
 		return fmt.Sprint(v)
 	})
 
 	e.Define("toInt", func(v interface{}) int64 {
+		if i := bigNumberToBigInt(v); i != nil {
+			if !i.IsInt64() {
+				panic(fmt.Sprintf("integer %v overflows int64", i))
+			}
+			return i.Int64()
+		}
 		rv := reflect.ValueOf(v)
 		if !rv.IsValid() {
 			return 0
@@ -73,6 +90,18 @@ func ImportToX(e *env.Env) {
 	})
 
 	e.Define("toFloat", func(v interface{}) float64 {
+		switch n := v.(type) {
+		case *big.Int:
+			if n != nil {
+				f, _ := new(big.Rat).SetInt(n).Float64()
+				return f
+			}
+		case *big.Rat:
+			if n != nil {
+				f, _ := n.Float64()
+				return f
+			}
+		}
 		rv := reflect.ValueOf(v)
 		if !rv.IsValid() {
 			return 0
@@ -144,6 +173,22 @@ func ImportToX(e *env.Env) {
 
 }
 
+// bigNumberToBigInt returns v as a *big.Int when it is a non-nil big number, truncating decimals toward zero.
+// It returns nil for any other value.
+func bigNumberToBigInt(v interface{}) *big.Int {
+	switch n := v.(type) {
+	case *big.Int:
+		if n != nil {
+			return n
+		}
+	case *big.Rat:
+		if n != nil {
+			return new(big.Int).Quo(n.Num(), n.Denom())
+		}
+	}
+	return nil
+}
+
 // toSlice takes in a "generic" slice and converts and copies
 // it's elements into the typed slice pointed at by ptr.
 // Note that this is a costly operation.
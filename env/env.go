@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 )
 
@@ -17,16 +18,30 @@ type (
 
 	// Env is the environment needed for a VM to run in.
 	Env struct {
-		rwMutex        *sync.RWMutex
-		parent         *Env
-		values         map[string]reflect.Value
-		types          map[string]reflect.Type
-		externalLookup ExternalLookup
+		rwMutex         *sync.RWMutex
+		parent          *Env
+		values          map[string]reflect.Value
+		types           map[string]reflect.Type
+		methods         map[reflect.Type]map[string]reflect.Value
+		consts          map[string]struct{}
+		externalLookup  ExternalLookup
+		packageRegistry *PackageRegistry
+	}
+
+	// ConstantError is returned when trying to change or delete a constant.
+	ConstantError struct {
+		Symbol string
+	}
+
+	// PrivateError is returned when trying to get a private symbol from outside of its module.
+	PrivateError struct {
+		Symbol string
 	}
 )
 
 var (
 	// Packages is a where packages can be stored so VM import command can be used to import them.
+	// They are used by Envs without a PackageRegistry, and by a PackageRegistry made with NewGlobalPackageRegistry.
 	// reflect.Value must be valid or VM may crash.
 	// For nil must use NilValue.
 	Packages = make(map[string]map[string]reflect.Value)
@@ -60,6 +75,22 @@ var (
 	ErrSymbolContainsDot = errors.New("symbol contains '.'")
 )
 
+// Error returns the constant error message.
+func (e *ConstantError) Error() string {
+	return fmt.Sprintf("cannot change constant '%s'", e.Symbol)
+}
+
+// Error returns the private error message.
+func (e *PrivateError) Error() string {
+	return fmt.Sprintf("cannot refer to private symbol '%s'", e.Symbol)
+}
+
+// IsPrivate returns true if the symbol is private to its module, which is when it starts with an underscore.
+// Private symbols can be used inside the module but not as a member of the module, like module._symbol.
+func IsPrivate(symbol string) bool {
+	return strings.HasPrefix(symbol, "_")
+}
+
 // NewEnv creates new global scope.
 func NewEnv() *Env {
 	return &Env{
@@ -88,6 +119,14 @@ func (e *Env) NewModule(symbol string) (*Env, error) {
 	return module, e.Define(symbol, module)
 }
 
+// Global returns the global scope, the Env without a parent.
+func (e *Env) Global() *Env {
+	for e.parent != nil {
+		e = e.parent
+	}
+	return e
+}
+
 // SetExternalLookup sets an external lookup
 func (e *Env) SetExternalLookup(externalLookup ExternalLookup) {
 	e.externalLookup = externalLookup
@@ -141,6 +180,9 @@ func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 
 	for i := 1; i < len(path); i++ {
 		// find child env
+		if IsPrivate(path[i]) {
+			return nil, &PrivateError{Symbol: path[i]}
+		}
 		value, ok = e.values[path[i]]
 		if ok {
 			e, ok = value.Interface().(*Env)
@@ -158,10 +200,11 @@ func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 func (e *Env) Copy() *Env {
 	e.rwMutex.RLock()
 	copy := Env{
-		rwMutex:        &sync.RWMutex{},
-		parent:         e.parent,
-		values:         make(map[string]reflect.Value, len(e.values)),
-		externalLookup: e.externalLookup,
+		rwMutex:         &sync.RWMutex{},
+		parent:          e.parent,
+		values:          make(map[string]reflect.Value, len(e.values)),
+		externalLookup:  e.externalLookup,
+		packageRegistry: e.packageRegistry,
 	}
 	for name, value := range e.values {
 		copy.values[name] = value
@@ -172,6 +215,21 @@ func (e *Env) Copy() *Env {
 			copy.types[name] = t
 		}
 	}
+	if e.consts != nil {
+		copy.consts = make(map[string]struct{}, len(e.consts))
+		for name := range e.consts {
+			copy.consts[name] = struct{}{}
+		}
+	}
+	if e.methods != nil {
+		copy.methods = make(map[reflect.Type]map[string]reflect.Value, len(e.methods))
+		for receiverType, methods := range e.methods {
+			copy.methods[receiverType] = make(map[string]reflect.Value, len(methods))
+			for name, method := range methods {
+				copy.methods[receiverType][name] = method
+			}
+		}
+	}
 	e.rwMutex.RUnlock()
 	return &copy
 }
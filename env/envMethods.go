@@ -0,0 +1,44 @@
+package env
+
+import (
+	"reflect"
+	"strings"
+)
+
+// DefineMethod defines method for the receiver type in current scope.
+// The method function takes the receiver as its first argument.
+func (e *Env) DefineMethod(receiverType reflect.Type, symbol string, method reflect.Value) error {
+	if strings.Contains(symbol, ".") {
+		return ErrSymbolContainsDot
+	}
+
+	e.rwMutex.Lock()
+	if e.methods == nil {
+		e.methods = make(map[reflect.Type]map[string]reflect.Value)
+	}
+	methods, ok := e.methods[receiverType]
+	if !ok {
+		methods = make(map[string]reflect.Value)
+		e.methods[receiverType] = methods
+	}
+	methods[symbol] = method
+	e.rwMutex.Unlock()
+
+	return nil
+}
+
+// Method returns method for the receiver type from the scope where it is first found.
+func (e *Env) Method(receiverType reflect.Type, symbol string) (reflect.Value, bool) {
+	e.rwMutex.RLock()
+	method, ok := e.methods[receiverType][symbol]
+	e.rwMutex.RUnlock()
+	if ok {
+		return method, true
+	}
+
+	if e.parent == nil {
+		return reflect.Value{}, false
+	}
+
+	return e.parent.Method(receiverType, symbol)
+}
@@ -0,0 +1,56 @@
+package env
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefineMethod(t *testing.T) {
+	env := NewEnv()
+	intType := reflect.TypeOf(int64(1))
+	stringType := reflect.TypeOf("a")
+	method := reflect.ValueOf(func(a int64) int64 { return a * 2 })
+
+	err := env.DefineMethod(intType, "a.b", method)
+	if err != ErrSymbolContainsDot {
+		t.Errorf("DefineMethod error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+
+	err = env.DefineMethod(intType, "double", method)
+	if err != nil {
+		t.Fatalf("DefineMethod error - %v", err)
+	}
+
+	child := env.NewEnv()
+	value, found := child.Method(intType, "double")
+	if !found {
+		t.Fatal("Method - not found")
+	}
+	if value.Interface().(func(int64) int64)(2) != 4 {
+		t.Errorf("Method - received wrong method")
+	}
+
+	_, found = child.Method(stringType, "double")
+	if found {
+		t.Errorf("Method - found method for wrong type")
+	}
+	_, found = child.Method(intType, "triple")
+	if found {
+		t.Errorf("Method - found method with wrong name")
+	}
+
+	err = child.DefineMethod(stringType, "double", method)
+	if err != nil {
+		t.Fatalf("DefineMethod error - %v", err)
+	}
+	_, found = env.Method(stringType, "double")
+	if found {
+		t.Errorf("Method - found method defined in child scope")
+	}
+
+	copy := child.Copy()
+	_, found = copy.Method(stringType, "double")
+	if !found {
+		t.Errorf("Method - copy did not copy methods")
+	}
+}
@@ -0,0 +1,292 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+type (
+	// PackageRegistry has the packages that the VM import command can import in the Env it is set on and its child scopes,
+	// with lists of the packages and symbols that are allowed or denied.
+	// When a package or symbol is allowed, only the allowed packages and symbols can be imported.
+	// Denied packages and symbols can never be imported.
+	// Script modules can only be imported if they are allowed with AllowModules.
+	PackageRegistry struct {
+		rwMutex        sync.RWMutex
+		packages       map[string]map[string]reflect.Value
+		packageTypes   map[string]map[string]reflect.Type
+		globalPackages bool
+		allowModules   bool
+		allowPackages  map[string]struct{}
+		allowSymbols   map[string]map[string]struct{}
+		denyPackages   map[string]struct{}
+		denySymbols    map[string]map[string]struct{}
+	}
+
+	// PackageNotFoundError is returned when importing a package that does not exist.
+	PackageNotFoundError struct {
+		Package string
+	}
+
+	// PackageNotAllowedError is returned when importing a package that is denied or not allowed.
+	PackageNotAllowedError struct {
+		Package string
+	}
+)
+
+// Error returns the package not found error message.
+func (e *PackageNotFoundError) Error() string {
+	return fmt.Sprintf("package not found: %s", e.Package)
+}
+
+// Error returns the package not allowed error message.
+func (e *PackageNotAllowedError) Error() string {
+	return fmt.Sprintf("package not allowed: %s", e.Package)
+}
+
+// NewPackageRegistry creates a PackageRegistry without packages.
+func NewPackageRegistry() *PackageRegistry {
+	return &PackageRegistry{
+		packages:     make(map[string]map[string]reflect.Value),
+		packageTypes: make(map[string]map[string]reflect.Type),
+	}
+}
+
+// NewGlobalPackageRegistry creates a PackageRegistry that also has the packages of the global Packages and PackageTypes,
+// so they can be restricted with allow and deny lists.
+// Packages and symbols defined in the PackageRegistry replace those of the global ones.
+func NewGlobalPackageRegistry() *PackageRegistry {
+	registry := NewPackageRegistry()
+	registry.globalPackages = true
+	return registry
+}
+
+// Define defines a symbol of the package.
+func (r *PackageRegistry) Define(pkg string, symbol string, value interface{}) error {
+	if value == nil {
+		return r.DefineValue(pkg, symbol, NilValue)
+	}
+	return r.DefineValue(pkg, symbol, reflect.ValueOf(value))
+}
+
+// DefineValue defines a symbol of the package with a reflect value.
+func (r *PackageRegistry) DefineValue(pkg string, symbol string, value reflect.Value) error {
+	if strings.Contains(symbol, ".") {
+		return ErrSymbolContainsDot
+	}
+
+	r.rwMutex.Lock()
+	values, ok := r.packages[pkg]
+	if !ok {
+		values = make(map[string]reflect.Value)
+		r.packages[pkg] = values
+	}
+	values[symbol] = value
+	r.rwMutex.Unlock()
+
+	return nil
+}
+
+// DefineType defines a type of the package.
+func (r *PackageRegistry) DefineType(pkg string, symbol string, aType interface{}) error {
+	var reflectType reflect.Type
+	if aType == nil {
+		reflectType = NilType
+	} else {
+		var ok bool
+		reflectType, ok = aType.(reflect.Type)
+		if !ok {
+			reflectType = reflect.TypeOf(aType)
+		}
+	}
+
+	if strings.Contains(symbol, ".") {
+		return ErrSymbolContainsDot
+	}
+
+	r.rwMutex.Lock()
+	types, ok := r.packageTypes[pkg]
+	if !ok {
+		types = make(map[string]reflect.Type)
+		r.packageTypes[pkg] = types
+	}
+	types[symbol] = reflectType
+	r.rwMutex.Unlock()
+
+	return nil
+}
+
+// AllowPackages allows importing all the symbols of the packages.
+func (r *PackageRegistry) AllowPackages(pkgs ...string) {
+	r.rwMutex.Lock()
+	if r.allowPackages == nil {
+		r.allowPackages = make(map[string]struct{})
+	}
+	for _, pkg := range pkgs {
+		r.allowPackages[pkg] = struct{}{}
+	}
+	r.rwMutex.Unlock()
+}
+
+// AllowSymbols allows importing the package with only the symbols, unless the whole package is allowed.
+func (r *PackageRegistry) AllowSymbols(pkg string, symbols ...string) {
+	r.rwMutex.Lock()
+	r.allowSymbols = addSymbols(r.allowSymbols, pkg, symbols)
+	r.rwMutex.Unlock()
+}
+
+// DenyPackages denies importing the packages.
+func (r *PackageRegistry) DenyPackages(pkgs ...string) {
+	r.rwMutex.Lock()
+	if r.denyPackages == nil {
+		r.denyPackages = make(map[string]struct{})
+	}
+	for _, pkg := range pkgs {
+		r.denyPackages[pkg] = struct{}{}
+	}
+	r.rwMutex.Unlock()
+}
+
+// DenySymbols denies importing the symbols of the package, the package is imported without them.
+func (r *PackageRegistry) DenySymbols(pkg string, symbols ...string) {
+	r.rwMutex.Lock()
+	r.denySymbols = addSymbols(r.denySymbols, pkg, symbols)
+	r.rwMutex.Unlock()
+}
+
+// AllowModules allows importing script modules by path, with the ModuleLoader of the VM options.
+func (r *PackageRegistry) AllowModules() {
+	r.rwMutex.Lock()
+	r.allowModules = true
+	r.rwMutex.Unlock()
+}
+
+// ModulesAllowed returns true if importing script modules is allowed.
+func (r *PackageRegistry) ModulesAllowed() bool {
+	r.rwMutex.RLock()
+	defer r.rwMutex.RUnlock()
+	return r.allowModules
+}
+
+// addSymbols adds the symbols of the package to the symbol list, making the list if it is nil
+func addSymbols(list map[string]map[string]struct{}, pkg string, symbols []string) map[string]map[string]struct{} {
+	if list == nil {
+		list = make(map[string]map[string]struct{})
+	}
+	pkgSymbols, ok := list[pkg]
+	if !ok {
+		pkgSymbols = make(map[string]struct{}, len(symbols))
+		list[pkg] = pkgSymbols
+	}
+	for _, symbol := range symbols {
+		pkgSymbols[symbol] = struct{}{}
+	}
+	return list
+}
+
+// Package returns the values and types of the package that are allowed.
+// Returns a PackageNotFoundError if the package does not exist,
+// or a PackageNotAllowedError if the package is denied or not allowed.
+func (r *PackageRegistry) Package(pkg string) (map[string]reflect.Value, map[string]reflect.Type, error) {
+	r.rwMutex.RLock()
+	defer r.rwMutex.RUnlock()
+
+	ownValues, hasValues := r.packages[pkg]
+	ownTypes, hasTypes := r.packageTypes[pkg]
+	var globalValues map[string]reflect.Value
+	var globalTypes map[string]reflect.Type
+	if r.globalPackages {
+		var ok bool
+		globalValues, ok = Packages[pkg]
+		hasValues = hasValues || ok
+		globalTypes, ok = PackageTypes[pkg]
+		hasTypes = hasTypes || ok
+	}
+	if !hasValues && !hasTypes {
+		return nil, nil, &PackageNotFoundError{Package: pkg}
+	}
+
+	if _, denied := r.denyPackages[pkg]; denied {
+		return nil, nil, &PackageNotAllowedError{Package: pkg}
+	}
+	_, allowPackage := r.allowPackages[pkg]
+	allowSymbols, allowSomeSymbols := r.allowSymbols[pkg]
+	allowAll := allowPackage || (r.allowPackages == nil && r.allowSymbols == nil)
+	if !allowAll && !allowSomeSymbols {
+		return nil, nil, &PackageNotAllowedError{Package: pkg}
+	}
+	denySymbols := r.denySymbols[pkg]
+	isAllowed := func(symbol string) bool {
+		if _, denied := denySymbols[symbol]; denied {
+			return false
+		}
+		if allowAll {
+			return true
+		}
+		_, allowed := allowSymbols[symbol]
+		return allowed
+	}
+
+	values := make(map[string]reflect.Value, len(globalValues)+len(ownValues))
+	for symbol, value := range globalValues {
+		if isAllowed(symbol) {
+			values[symbol] = value
+		}
+	}
+	for symbol, value := range ownValues {
+		if isAllowed(symbol) {
+			values[symbol] = value
+		}
+	}
+	types := make(map[string]reflect.Type, len(globalTypes)+len(ownTypes))
+	for symbol, aType := range globalTypes {
+		if isAllowed(symbol) {
+			types[symbol] = aType
+		}
+	}
+	for symbol, aType := range ownTypes {
+		if isAllowed(symbol) {
+			types[symbol] = aType
+		}
+	}
+	return values, types, nil
+}
+
+// SetPackageRegistry sets the PackageRegistry used to import packages in the Env and its child scopes.
+// If no scope has a PackageRegistry, the global Packages and PackageTypes are used.
+func (e *Env) SetPackageRegistry(registry *PackageRegistry) {
+	e.rwMutex.Lock()
+	e.packageRegistry = registry
+	e.rwMutex.Unlock()
+}
+
+// PackageRegistry returns the PackageRegistry of the scope where it is first found, or nil if there is none.
+func (e *Env) PackageRegistry() *PackageRegistry {
+	for ; e != nil; e = e.parent {
+		e.rwMutex.RLock()
+		registry := e.packageRegistry
+		e.rwMutex.RUnlock()
+		if registry != nil {
+			return registry
+		}
+	}
+	return nil
+}
+
+// Package returns the values and types of the package to import in the Env,
+// from the PackageRegistry of the Env or from the global Packages and PackageTypes if there is none.
+// Returns a PackageNotFoundError if the package does not exist,
+// or a PackageNotAllowedError if the PackageRegistry does not allow the package.
+func (e *Env) Package(pkg string) (map[string]reflect.Value, map[string]reflect.Type, error) {
+	registry := e.PackageRegistry()
+	if registry != nil {
+		return registry.Package(pkg)
+	}
+	values, ok := Packages[pkg]
+	if !ok {
+		return nil, nil, &PackageNotFoundError{Package: pkg}
+	}
+	return values, PackageTypes[pkg], nil
+}
@@ -0,0 +1,143 @@
+package env
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestPackageRegistry(t *testing.T) {
+	Packages["envTest"] = map[string]reflect.Value{"Global": reflect.ValueOf(1), "Replaced": reflect.ValueOf(1)}
+	PackageTypes["envTest"] = map[string]reflect.Type{"GlobalType": reflect.TypeOf(1)}
+	defer func() {
+		delete(Packages, "envTest")
+		delete(PackageTypes, "envTest")
+	}()
+
+	newRegistry := func(global bool) *PackageRegistry {
+		registry := NewPackageRegistry()
+		if global {
+			registry = NewGlobalPackageRegistry()
+		}
+		for _, pkg := range []string{"envTest", "strings", "os"} {
+			registry.Define(pkg, "Replaced", 2)
+			registry.Define(pkg, "Own", 2)
+			registry.DefineType(pkg, "OwnType", "")
+		}
+		return registry
+	}
+
+	tests := []struct {
+		testInfo  string
+		global    bool
+		setup     func(registry *PackageRegistry)
+		pkg       string
+		symbols   string
+		packError string
+	}{
+		{testInfo: "own", pkg: "envTest", symbols: "Own OwnType Replaced"},
+		{testInfo: "global", global: true, pkg: "envTest", symbols: "Global GlobalType Own OwnType Replaced"},
+		{testInfo: "not found", pkg: "other", packError: "package not found: other"},
+		{testInfo: "global not found", global: true, pkg: "other", packError: "package not found: other"},
+
+		{testInfo: "deny package", global: true, setup: func(r *PackageRegistry) { r.DenyPackages("os") }, pkg: "os", packError: "package not allowed: os"},
+		{testInfo: "deny other package", global: true, setup: func(r *PackageRegistry) { r.DenyPackages("os") }, pkg: "envTest", symbols: "Global GlobalType Own OwnType Replaced"},
+		{testInfo: "deny symbols", global: true, setup: func(r *PackageRegistry) { r.DenySymbols("envTest", "Global", "OwnType") }, pkg: "envTest", symbols: "GlobalType Own Replaced"},
+
+		{testInfo: "allow package", global: true, setup: func(r *PackageRegistry) { r.AllowPackages("strings") }, pkg: "strings", symbols: "Own OwnType Replaced"},
+		{testInfo: "not allowed package", global: true, setup: func(r *PackageRegistry) { r.AllowPackages("strings") }, pkg: "envTest", packError: "package not allowed: envTest"},
+		{testInfo: "allow symbols", global: true, setup: func(r *PackageRegistry) { r.AllowSymbols("envTest", "Global", "OwnType") }, pkg: "envTest", symbols: "Global OwnType"},
+		{testInfo: "allow symbols other package", global: true, setup: func(r *PackageRegistry) { r.AllowSymbols("envTest", "Global") }, pkg: "os", packError: "package not allowed: os"},
+		{testInfo: "allow package and symbols", global: true, setup: func(r *PackageRegistry) { r.AllowPackages("envTest"); r.AllowSymbols("envTest", "Global") }, pkg: "envTest", symbols: "Global GlobalType Own OwnType Replaced"},
+		{testInfo: "allow and deny package", global: true, setup: func(r *PackageRegistry) { r.AllowPackages("envTest"); r.DenyPackages("envTest") }, pkg: "envTest", packError: "package not allowed: envTest"},
+		{testInfo: "allow and deny symbols", global: true, setup: func(r *PackageRegistry) { r.AllowSymbols("envTest", "Global", "Own"); r.DenySymbols("envTest", "Own") }, pkg: "envTest", symbols: "Global"},
+	}
+
+	for _, test := range tests {
+		registry := newRegistry(test.global)
+		if test.setup != nil {
+			test.setup(registry)
+		}
+
+		values, types, err := registry.Package(test.pkg)
+		if err != nil || test.packError != "" {
+			if err == nil || err.Error() != test.packError {
+				t.Errorf("Package %v - error - received: %v - expected: %v", test.testInfo, err, test.packError)
+			}
+			continue
+		}
+
+		var symbols []string
+		for symbol := range values {
+			symbols = append(symbols, symbol)
+		}
+		for symbol := range types {
+			symbols = append(symbols, symbol)
+		}
+		sort.Strings(symbols)
+		if strings.Join(symbols, " ") != test.symbols {
+			t.Errorf("Package %v - symbols - received: %v - expected: %v", test.testInfo, strings.Join(symbols, " "), test.symbols)
+		}
+		if value, ok := values["Replaced"]; ok && value.Interface() != 2 {
+			t.Errorf("Package %v - Replaced - received: %v - expected: %v", test.testInfo, value, 2)
+		}
+	}
+
+	err := NewPackageRegistry().Define("envTest", "a.b", 1)
+	if err != ErrSymbolContainsDot {
+		t.Errorf("Define - error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+
+	registry := NewPackageRegistry()
+	if registry.ModulesAllowed() {
+		t.Errorf("ModulesAllowed - received: %v - expected: %v", true, false)
+	}
+	registry.AllowModules()
+	if !registry.ModulesAllowed() {
+		t.Errorf("ModulesAllowed - received: %v - expected: %v", false, true)
+	}
+}
+
+func TestEnvPackage(t *testing.T) {
+	Packages["envTest"] = map[string]reflect.Value{"Global": reflect.ValueOf(1)}
+	defer delete(Packages, "envTest")
+
+	// without a PackageRegistry the global packages are used
+	global := NewEnv()
+	values, _, err := global.Package("envTest")
+	if err != nil {
+		t.Fatal("Package error:", err)
+	}
+	if _, ok := values["Global"]; !ok {
+		t.Errorf("Package - Global not found")
+	}
+	_, _, err = global.Package("other")
+	if err == nil || err.Error() != "package not found: other" {
+		t.Errorf("Package error - received: %v - expected: %v", err, "package not found: other")
+	}
+
+	// child scopes and copies use the PackageRegistry of the parent
+	registry := NewPackageRegistry()
+	global.SetPackageRegistry(registry)
+	child := global.NewEnv().NewEnv()
+	if child.PackageRegistry() != registry {
+		t.Errorf("PackageRegistry of child scope is not the registry of the global scope")
+	}
+	if global.Copy().PackageRegistry() != registry {
+		t.Errorf("PackageRegistry of copy is not the registry of the global scope")
+	}
+	_, _, err = child.Package("envTest")
+	if err == nil || err.Error() != "package not found: envTest" {
+		t.Errorf("Package error - received: %v - expected: %v", err, "package not found: envTest")
+	}
+
+	childRegistry := NewGlobalPackageRegistry()
+	child.SetPackageRegistry(childRegistry)
+	if child.NewEnv().PackageRegistry() != childRegistry {
+		t.Errorf("PackageRegistry of child scope is not the registry of the closest scope")
+	}
+	if global.PackageRegistry() != registry {
+		t.Errorf("PackageRegistry of global scope changed by child scope")
+	}
+}
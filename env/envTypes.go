@@ -81,3 +81,16 @@ func (e *Env) Type(symbol string) (reflect.Type, error) {
 
 	return e.parent.Type(symbol)
 }
+
+// ExportedTypes returns the public types defined in current scope, the types of the API of a module.
+func (e *Env) ExportedTypes() map[string]reflect.Type {
+	e.rwMutex.RLock()
+	types := make(map[string]reflect.Type, len(e.types))
+	for symbol, reflectType := range e.types {
+		if !IsPrivate(symbol) {
+			types[symbol] = reflectType
+		}
+	}
+	e.rwMutex.RUnlock()
+	return types
+}
@@ -17,17 +17,50 @@ func (e *Env) Define(symbol string, value interface{}) error {
 }
 
 // DefineValue defines/sets reflect value to symbol in current scope.
+// Returns a ConstantError if symbol is a constant in current scope.
 func (e *Env) DefineValue(symbol string, value reflect.Value) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
 	e.rwMutex.Lock()
+	if _, ok := e.consts[symbol]; ok {
+		e.rwMutex.Unlock()
+		return &ConstantError{Symbol: symbol}
+	}
 	e.values[symbol] = value
 	e.rwMutex.Unlock()
 
 	return nil
 }
 
+// DefineConst defines interface value to symbol as a constant in current scope.
+func (e *Env) DefineConst(symbol string, value interface{}) error {
+	if value == nil {
+		return e.DefineConstValue(symbol, NilValue)
+	}
+	return e.DefineConstValue(symbol, reflect.ValueOf(value))
+}
+
+// DefineConstValue defines reflect value to symbol as a constant in current scope.
+// A constant can not be set, defined again or deleted.
+func (e *Env) DefineConstValue(symbol string, value reflect.Value) error {
+	if strings.Contains(symbol, ".") {
+		return ErrSymbolContainsDot
+	}
+	e.rwMutex.Lock()
+	defer e.rwMutex.Unlock()
+	if _, ok := e.consts[symbol]; ok {
+		return &ConstantError{Symbol: symbol}
+	}
+	if e.consts == nil {
+		e.consts = make(map[string]struct{})
+	}
+	e.consts[symbol] = struct{}{}
+	e.values[symbol] = value
+
+	return nil
+}
+
 // DefineGlobal defines/sets interface value to symbol in global scope.
 func (e *Env) DefineGlobal(symbol string, value interface{}) error {
 	for e.parent != nil {
@@ -55,10 +88,15 @@ func (e *Env) Set(symbol string, value interface{}) error {
 }
 
 // SetValue reflect value to the scope where symbol is frist found.
+// Returns a ConstantError if symbol is a constant.
 func (e *Env) SetValue(symbol string, value reflect.Value) error {
 	e.rwMutex.RLock()
 	_, ok := e.values[symbol]
+	_, isConst := e.consts[symbol]
 	e.rwMutex.RUnlock()
+	if isConst {
+		return &ConstantError{Symbol: symbol}
+	}
 	if ok {
 		e.rwMutex.Lock()
 		e.values[symbol] = value
@@ -104,16 +142,51 @@ func (e *Env) GetValue(symbol string) (reflect.Value, error) {
 	return e.parent.GetValue(symbol)
 }
 
+// GetMemberValue gets reflect value from the scope where symbol is first found, as a member of the Env like module.symbol.
+// Returns a PrivateError if symbol is private.
+func (e *Env) GetMemberValue(symbol string) (reflect.Value, error) {
+	if IsPrivate(symbol) {
+		return NilValue, &PrivateError{Symbol: symbol}
+	}
+	return e.GetValue(symbol)
+}
+
+// ExportedValues returns the values of the public symbols defined in current scope, the API of a module.
+func (e *Env) ExportedValues() map[string]reflect.Value {
+	e.rwMutex.RLock()
+	values := make(map[string]reflect.Value, len(e.values))
+	for symbol, value := range e.values {
+		if !IsPrivate(symbol) {
+			values[symbol] = value
+		}
+	}
+	e.rwMutex.RUnlock()
+	return values
+}
+
 // delete
 
-// Delete deletes symbol in current scope.
+// Delete deletes symbol in current scope, even if it is a constant.
 func (e *Env) Delete(symbol string) {
 	e.rwMutex.Lock()
 	delete(e.values, symbol)
+	delete(e.consts, symbol)
 	e.rwMutex.Unlock()
 }
 
-// DeleteGlobal deletes the first matching symbol found in current or parent scope.
+// DeleteChecked deletes symbol in current scope.
+// Returns a ConstantError if symbol is a constant.
+func (e *Env) DeleteChecked(symbol string) error {
+	e.rwMutex.Lock()
+	defer e.rwMutex.Unlock()
+	if _, ok := e.consts[symbol]; ok {
+		return &ConstantError{Symbol: symbol}
+	}
+	delete(e.values, symbol)
+	return nil
+}
+
+// DeleteGlobal deletes the first matching symbol found in current or parent scope, even if it is a constant.
 func (e *Env) DeleteGlobal(symbol string) {
 	if e.parent == nil {
 		e.Delete(symbol)
@@ -132,6 +205,24 @@ func (e *Env) DeleteGlobal(symbol string) {
 	e.parent.DeleteGlobal(symbol)
 }
 
+// DeleteGlobalChecked deletes the first matching symbol found in current or parent scope.
+// Returns a ConstantError if symbol is a constant.
+func (e *Env) DeleteGlobalChecked(symbol string) error {
+	if e.parent == nil {
+		return e.DeleteChecked(symbol)
+	}
+
+	e.rwMutex.RLock()
+	_, ok := e.values[symbol]
+	e.rwMutex.RUnlock()
+
+	if ok {
+		return e.DeleteChecked(symbol)
+	}
+
+	return e.parent.DeleteGlobalChecked(symbol)
+}
+
 // Addr
 
 // Addr returns reflect.Addr of value for first matching symbol found in current or parent scope.
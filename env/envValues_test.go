@@ -425,6 +425,84 @@ func TestAddr(t *testing.T) {
 	}
 }
 
+func TestDefineConst(t *testing.T) {
+	env := NewEnv()
+	err := env.DefineConst("a.b", "a")
+	if err == nil || err != ErrSymbolContainsDot {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+
+	err = env.DefineConst("a", "a")
+	if err != nil {
+		t.Fatalf("DefineConst error - received: %v - expected: %v", err, nil)
+	}
+
+	expectedError := "cannot change constant 'a'"
+	err = env.DefineConst("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, expectedError)
+	}
+	err = env.Define("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Define error - received: %v - expected: %v", err, expectedError)
+	}
+	err = env.Set("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Set error - received: %v - expected: %v", err, expectedError)
+	}
+	err = env.DeleteChecked("a")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("DeleteChecked error - received: %v - expected: %v", err, expectedError)
+	}
+	if _, ok := err.(*ConstantError); !ok {
+		t.Errorf("DeleteChecked error type - received: %T - expected: %T", err, &ConstantError{})
+	}
+
+	// child scope can not set or delete but can define
+	envChild := env.NewEnv()
+	err = envChild.Set("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Set error - received: %v - expected: %v", err, expectedError)
+	}
+	err = envChild.DeleteGlobalChecked("a")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("DeleteGlobalChecked error - received: %v - expected: %v", err, expectedError)
+	}
+	err = envChild.Define("a", "b")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+	value, err := envChild.Get("a")
+	if err != nil || value != "b" {
+		t.Errorf("Get - received: %#v, %v - expected: %#v, %v", value, err, "b", nil)
+	}
+
+	// copy keeps constants
+	envCopy := env.Copy()
+	err = envCopy.Set("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Set error - received: %v - expected: %v", err, expectedError)
+	}
+
+	value, err = env.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %#v, %v - expected: %#v, %v", value, err, "a", nil)
+	}
+
+	// Delete deletes constants
+	envChild.Delete("a")
+	envChild.DeleteGlobal("a")
+	_, err = env.Get("a")
+	expectedError = "undefined symbol 'a'"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Get error - received: %v - expected: %v", err, expectedError)
+	}
+	err = env.Define("a", "b")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	// empty
 	env := NewEnv()
@@ -444,6 +522,48 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestPrivateMembers(t *testing.T) {
+	env := NewEnv()
+	module, _ := env.NewModule("a")
+	module.Define("b", "b")
+	module.Define("_c", "c")
+
+	value, err := module.GetMemberValue("b")
+	if err != nil || value.Interface() != "b" {
+		t.Errorf("GetMemberValue - received: %v, %v - expected: %v", value, err, "b")
+	}
+	value, err = module.GetMemberValue("_c")
+	expectedError := "cannot refer to private symbol '_c'"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("GetMemberValue error - received: %v - expected: %v", err, expectedError)
+	}
+	if _, ok := err.(*PrivateError); !ok {
+		t.Errorf("GetMemberValue error type - received: %T - expected: %T", err, &PrivateError{})
+	}
+	value, err = module.GetValue("_c")
+	if err != nil || value.Interface() != "c" {
+		t.Errorf("GetValue - received: %v, %v - expected: %v", value, err, "c")
+	}
+
+	module.DefineType("d", int64(0))
+	module.DefineType("_e", int64(0))
+	values := module.ExportedValues()
+	if len(values) != 1 || values["b"].Interface() != "b" {
+		t.Errorf("ExportedValues - received: %v - expected: map[b:b]", values)
+	}
+	types := module.ExportedTypes()
+	if len(types) != 1 || types["d"] != reflect.TypeOf(int64(0)) {
+		t.Errorf("ExportedTypes - received: %v - expected: map[d:int64]", types)
+	}
+
+	module.NewModule("_f")
+	_, err = env.GetEnvFromPath([]string{"a", "_f"})
+	expectedError = "cannot refer to private symbol '_f'"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("GetEnvFromPath error - received: %v - expected: %v", err, expectedError)
+	}
+}
+
 func TestDeleteGlobal(t *testing.T) {
 	// empty
 	env := NewEnv()
@@ -282,6 +282,21 @@ func TestGetEnvFromPath(t *testing.T) {
 	}
 }
 
+func TestGlobal(t *testing.T) {
+	global := NewEnv()
+	if global.Global() != global {
+		t.Errorf("global of global scope is not itself")
+	}
+	child := global.NewEnv().NewEnv()
+	if child.Global() != global {
+		t.Errorf("global of child scope is not global scope")
+	}
+	module, _ := child.NewModule("a")
+	if module.Global() != global {
+		t.Errorf("global of module is not global scope")
+	}
+}
+
 func TestCopy(t *testing.T) {
 	parent := NewEnv()
 	parent.Define("a", "a")
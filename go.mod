@@ -0,0 +1,3 @@
+module github.com/gbl08ma/anko
+
+go 1.21
@@ -4,6 +4,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -38,40 +39,47 @@ type Scanner struct {
 	offset   int
 	lineHead int
 	line     int
+
+	// interpolatedString is set by scanString when the string has embedded expressions
+	interpolatedString *ast.InterpolatedStringExpr
 }
 
 // opName is correction of operation names.
 var opName = map[string]int{
-	"func":     FUNC,
-	"return":   RETURN,
-	"var":      VAR,
-	"throw":    THROW,
-	"if":       IF,
-	"for":      FOR,
-	"break":    BREAK,
-	"continue": CONTINUE,
-	"in":       IN,
-	"else":     ELSE,
-	"new":      NEW,
-	"true":     TRUE,
-	"false":    FALSE,
-	"nil":      NIL,
-	"module":   MODULE,
-	"try":      TRY,
-	"catch":    CATCH,
-	"finally":  FINALLY,
-	"switch":   SWITCH,
-	"case":     CASE,
-	"default":  DEFAULT,
-	"go":       GO,
-	"chan":     CHAN,
-	"make":     MAKE,
-	"type":     TYPE,
-	"len":      LEN,
-	"delete":   DELETE,
-	"close":    CLOSE,
-	"map":      MAP,
-	"import":   IMPORT,
+	"func":        FUNC,
+	"return":      RETURN,
+	"var":         VAR,
+	"const":       CONST,
+	"throw":       THROW,
+	"if":          IF,
+	"for":         FOR,
+	"break":       BREAK,
+	"continue":    CONTINUE,
+	"in":          IN,
+	"else":        ELSE,
+	"new":         NEW,
+	"true":        TRUE,
+	"false":       FALSE,
+	"nil":         NIL,
+	"module":      MODULE,
+	"try":         TRY,
+	"catch":       CATCH,
+	"finally":     FINALLY,
+	"switch":      SWITCH,
+	"case":        CASE,
+	"default":     DEFAULT,
+	"fallthrough": FALLTHROUGH,
+	"go":          GO,
+	"defer":       DEFER,
+	"chan":        CHAN,
+	"make":        MAKE,
+	"type":        TYPE,
+	"len":         LEN,
+	"delete":      DELETE,
+	"close":       CLOSE,
+	"map":         MAP,
+	"import":      IMPORT,
+	"select":      SELECT,
 }
 
 var (
@@ -99,6 +107,9 @@ retry:
 		}
 		if name, ok := opName[lit]; ok {
 			tok = name
+		} else if lit == "struct" && s.isFollowedBy('{') {
+			// struct is only a keyword before the fields of a struct type, so it can still be used as a name
+			tok = STRUCT
 		} else {
 			tok = IDENT
 		}
@@ -114,6 +125,10 @@ retry:
 		if err != nil {
 			return
 		}
+		if s.interpolatedString != nil {
+			tok = INTERPOLATEDSTRING
+			s.interpolatedString.SetPosition(pos)
+		}
 	case ch == '\'':
 		tok = STRING
 		lit, err = s.scanString('\'')
@@ -152,6 +167,9 @@ retry:
 			case '=':
 				tok = EQEQ
 				lit = "=="
+			case '>':
+				tok = ARROW
+				lit = "=>"
 			default:
 				s.back()
 				tok = int(ch)
@@ -163,6 +181,16 @@ retry:
 			case '?':
 				tok = NILCOALESCE
 				lit = "??"
+			case '.':
+				s.next()
+				if s.peek() == '[' {
+					tok = OPTINDEX
+					lit = "?.["
+				} else {
+					s.back()
+					tok = OPTCHAIN
+					lit = "?."
+				}
 			default:
 				s.back()
 				tok = int(ch)
@@ -311,7 +339,14 @@ retry:
 				tok = int(ch)
 				lit = string(ch)
 			}
-		case '\n', '(', ')', ':', ';', '%', '{', '}', '[', ']', ',', '^':
+		case '{':
+			tok = int(ch)
+			lit = string(ch)
+			if s.isPrecededBy("=>") {
+				// the block of an arrow function, never a map literal
+				tok = ARROWBLOCK
+			}
+		case '\n', '(', ')', ':', ';', '%', '}', '[', ']', ',', '^':
 			tok = int(ch)
 			lit = string(ch)
 		default:
@@ -384,6 +419,26 @@ func (s *Scanner) back() {
 	s.offset--
 }
 
+// isFollowedBy returns true if the next rune on the line that is not a space or tab is ch.
+func (s *Scanner) isFollowedBy(ch rune) bool {
+	for i := s.offset; i < len(s.src); i++ {
+		if s.src[i] != ' ' && s.src[i] != '\t' {
+			return s.src[i] == ch
+		}
+	}
+	return false
+}
+
+// isPrecededBy returns true if the runes on the line before the current one that are not spaces or tabs end with str.
+func (s *Scanner) isPrecededBy(str string) bool {
+	i := s.offset - 1
+	for i >= 0 && (s.src[i] == ' ' || s.src[i] == '\t') {
+		i--
+	}
+	start := i + 1 - len([]rune(str))
+	return start >= 0 && string(s.src[start:i+1]) == str
+}
+
 // reachEOF returns true if offset is at end-of-file.
 func (s *Scanner) reachEOF() bool {
 	return len(s.src) <= s.offset
@@ -471,6 +526,12 @@ func (s *Scanner) scanNumber() (string, error) {
 		}
 	}
 
+	if s.peek() == 'n' || (s.peek() == 'd' && !strings.HasPrefix(string(result), "0x")) {
+		// big integer or decimal suffix
+		result = append(result, s.peek())
+		s.next()
+	}
+
 	if isLetter(s.peek()) {
 		return "", errors.New("identifier starts immediately after numeric literal")
 	}
@@ -497,8 +558,13 @@ func (s *Scanner) scanRawString(l rune) (string, error) {
 
 // scanString returns string starting at current position.
 // This handles backslash escaping.
+// Double quoted strings can have embedded expressions like "a ${b} c",
+// in which case the string parts and expressions are set in interpolatedString.
 func (s *Scanner) scanString(l rune) (string, error) {
 	var ret []rune
+	var interpolatedString *ast.InterpolatedStringExpr
+	var interpolationErr error
+	s.interpolatedString = nil
 eos:
 	for {
 		s.next()
@@ -510,6 +576,31 @@ eos:
 		case l:
 			s.next()
 			break eos
+		case '$':
+			s.next()
+			if l != '"' || s.peek() != '{' {
+				s.back()
+				ret = append(ret, s.peek())
+				continue
+			}
+			s.next()
+			expr, err := s.scanInterpolation()
+			if err != nil {
+				if s.peek() != '}' {
+					return "", err
+				}
+				// keep scanning the rest of the string so the error is not followed by syntax errors
+				if interpolationErr == nil {
+					interpolationErr = err
+				}
+				continue
+			}
+			if interpolatedString == nil {
+				interpolatedString = &ast.InterpolatedStringExpr{}
+			}
+			interpolatedString.Strings = append(interpolatedString.Strings, string(ret))
+			interpolatedString.Exprs = append(interpolatedString.Exprs, expr)
+			ret = nil
 		case '\\':
 			s.next()
 			switch s.peek() {
@@ -535,9 +626,64 @@ eos:
 			ret = append(ret, s.peek())
 		}
 	}
+	if interpolationErr != nil {
+		return "", interpolationErr
+	}
+	if interpolatedString != nil {
+		interpolatedString.Strings = append(interpolatedString.Strings, string(ret))
+		s.interpolatedString = interpolatedString
+	}
 	return string(ret), nil
 }
 
+// scanInterpolation parses the expression embedded in a string starting at current position.
+// If the closing '}' is found, returns with the current position at it, even on error.
+func (s *Scanner) scanInterpolation() (ast.Expr, error) {
+	start := s.pos()
+
+	// find the closing '}' by scanning tokens, so braces inside of strings are skipped
+	scanner := *s
+	depth := 0
+	for {
+		tok, _, pos, err := scanner.Scan()
+		if err != nil {
+			return nil, &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		}
+		switch tok {
+		case EOF:
+			return nil, errors.New("unexpected EOF")
+		case EOL:
+			return nil, errors.New("unexpected EOL")
+		case '{':
+			depth++
+			continue
+		case '}':
+			if depth > 0 {
+				depth--
+				continue
+			}
+		default:
+			continue
+		}
+		break
+	}
+	end := scanner.offset - 1
+
+	exprScanner := &Scanner{src: s.src[:end], offset: s.offset, lineHead: s.lineHead, line: s.line}
+	s.offset = end
+	stmt, err := Parse(exprScanner)
+	if err != nil {
+		return nil, err
+	}
+
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok && len(stmts.Stmts) == 1 {
+		if exprStmt, ok := stmts.Stmts[0].(*ast.ExprStmt); ok {
+			return exprStmt.Expr, nil
+		}
+	}
+	return nil, &Error{Message: "string interpolation must be an expression", Pos: start, Fatal: true}
+}
+
 // Lexer provides interface to parse codes.
 type Lexer struct {
 	s    *Scanner
@@ -545,13 +691,26 @@ type Lexer struct {
 	pos  ast.Position
 	e    error
 	stmt ast.Stmt
+
+	// labels of break and continue statements not yet matched to a loop
+	labels []ast.Token
+	// fallthrough statements not yet matched to a switch case
+	fallthroughs []*ast.FallthroughStmt
 }
 
 // Lex scans the token and literals.
 func (l *Lexer) Lex(lval *yySymType) int {
 	tok, lit, pos, err := l.s.Scan()
 	if err != nil {
-		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		if parseError, ok := err.(*Error); ok {
+			// errors from string interpolation have the position of the embedded expression
+			l.e = &Error{Message: parseError.Message, Pos: parseError.Pos, Fatal: true}
+		} else {
+			l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		}
+	}
+	if tok == INTERPOLATEDSTRING {
+		lval.expr = l.s.interpolatedString
 	}
 	lval.tok = ast.Token{Tok: tok, Lit: lit}
 	lval.tok.SetPosition(pos)
@@ -565,12 +724,93 @@ func (l *Lexer) Error(msg string) {
 	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false}
 }
 
+// matchLabel removes the break and continue labels after the loop label that have the same name.
+// Loops are parsed after their statements, so these are the ones inside of the loop.
+func (l *Lexer) matchLabel(loopLabel ast.Token) {
+	loopPos := loopLabel.Position()
+	labels := l.labels[:0]
+	for _, label := range l.labels {
+		if label.Lit == loopLabel.Lit && isAfter(label.Position(), loopPos) {
+			continue
+		}
+		labels = append(labels, label)
+	}
+	l.labels = labels
+}
+
+// endFunction reports the break and continue labels inside of the function starting at funcPos that did not match a loop.
+// Functions are parsed after their statements, and a label can not be for a loop outside of the function.
+func (l *Lexer) endFunction(funcPos ast.Position) {
+	labels := l.labels[:0]
+	for _, label := range l.labels {
+		if isAfter(label.Position(), funcPos) {
+			l.undefinedLabel(label)
+			continue
+		}
+		labels = append(labels, label)
+	}
+	l.labels = labels
+}
+
+// undefinedLabel sets the parse error for a break or continue label that did not match a loop.
+func (l *Lexer) undefinedLabel(label ast.Token) {
+	l.fatalError("undefined label '"+label.Lit+"'", label.Position())
+}
+
+// matchFallthroughs removes the fallthrough statements that end the cases of the switch.
+// A fallthrough can only be the last statement of a case, and the default case is run after the other cases,
+// so it is an error for the last of them.
+func (l *Lexer) matchFallthroughs(switchStmt *ast.SwitchStmt) {
+	last := len(switchStmt.Cases) - 1
+	if switchStmt.Default != nil {
+		last++
+	}
+	for i, caseStmt := range switchStmt.Cases {
+		stmts, ok := caseStmt.(*ast.SwitchCaseStmt).Stmt.(*ast.StmtsStmt)
+		if !ok || len(stmts.Stmts) == 0 {
+			continue
+		}
+		fallthroughStmt, ok := stmts.Stmts[len(stmts.Stmts)-1].(*ast.FallthroughStmt)
+		if !ok {
+			continue
+		}
+		if i == last {
+			l.fatalError("cannot fallthrough final case in switch", fallthroughStmt.Position())
+		}
+		fallthroughs := l.fallthroughs[:0]
+		for _, stmt := range l.fallthroughs {
+			if stmt != fallthroughStmt {
+				fallthroughs = append(fallthroughs, stmt)
+			}
+		}
+		l.fallthroughs = fallthroughs
+	}
+}
+
+// fatalError sets the parse error, unless there already is an error.
+func (l *Lexer) fatalError(msg string, pos ast.Position) {
+	if l.e == nil {
+		l.e = &Error{Message: msg, Pos: pos, Fatal: true}
+	}
+}
+
+// isAfter returns true if pos is after start.
+func isAfter(pos ast.Position, start ast.Position) bool {
+	return pos.Line > start.Line || (pos.Line == start.Line && pos.Column > start.Column)
+}
+
 // Parse provides way to parse the code using Scanner.
 func Parse(s *Scanner) (ast.Stmt, error) {
 	l := Lexer{s: s}
 	if yyParse(&l) != 0 {
 		return nil, l.e
 	}
+	if len(l.labels) > 0 {
+		l.undefinedLabel(l.labels[0])
+	}
+	if len(l.fallthroughs) > 0 {
+		l.fatalError("fallthrough statement out of place", l.fallthroughs[0].Position())
+	}
 	return l.stmt, l.e
 }
 
@@ -588,6 +828,20 @@ func ParseSrc(src string) (ast.Stmt, error) {
 }
 
 func toNumber(numString string) (reflect.Value, error) {
+	// big integer
+	if strings.HasSuffix(numString, "n") {
+		return toBigInt(numString[:len(numString)-1])
+	}
+
+	// decimal, an exact fraction that does not keep the scale of the literal
+	if strings.HasSuffix(numString, "d") {
+		r, ok := new(big.Rat).SetString(numString[:len(numString)-1])
+		if !ok {
+			return nilValue, errors.New("invalid decimal literal")
+		}
+		return reflect.ValueOf(r), nil
+	}
+
 	// hex
 	if len(numString) > 2 && numString[0:2] == "0x" {
 		i, err := strconv.ParseInt(numString[2:], 16, 64)
@@ -623,6 +877,99 @@ func toNumber(numString string) (reflect.Value, error) {
 	return reflect.ValueOf(i), nil
 }
 
+func toBigInt(numString string) (reflect.Value, error) {
+	if strings.Contains(numString, ".") || strings.Contains(numString, "e") {
+		return nilValue, errors.New("invalid big integer literal")
+	}
+	negative := strings.HasPrefix(numString, "-")
+	if negative {
+		numString = numString[1:]
+	}
+	base := 10
+	if strings.HasPrefix(numString, "0x") {
+		numString = numString[2:]
+		base = 16
+	}
+	i, ok := new(big.Int).SetString(numString, base)
+	if !ok {
+		return nilValue, errors.New("invalid big integer literal")
+	}
+	if negative {
+		i.Neg(i)
+	}
+	return reflect.ValueOf(i), nil
+}
+
+// callArgs returns the call arguments and if the call is variadic.
+// A spread of the last argument only is a variadic call, like f(a, b...) in Go,
+// other spreads are kept in the arguments to be expanded when the call is run.
+func callArgs(exprs []ast.Expr) ([]ast.Expr, bool) {
+	if len(exprs) == 0 {
+		return exprs, false
+	}
+	for _, expr := range exprs[:len(exprs)-1] {
+		if _, ok := expr.(*ast.SpreadExpr); ok {
+			return exprs, false
+		}
+	}
+	spreadExpr, ok := exprs[len(exprs)-1].(*ast.SpreadExpr)
+	if !ok {
+		return exprs, false
+	}
+	exprs[len(exprs)-1] = spreadExpr.Expr
+	return exprs, true
+}
+
+// isOptionalChain returns true if the expression is part of an optional chain,
+// in which case the expressions chained after it are optional too
+func isOptionalChain(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.MemberExpr:
+		return expr.Optional
+	case *ast.ItemExpr:
+		return expr.Optional
+	case *ast.AnonCallExpr:
+		return expr.Optional
+	}
+	return false
+}
+
+// newComprehensionExpr returns a list comprehension when key is nil, otherwise a map comprehension.
+// Like for in loops, one or two identifiers are needed.
+func newComprehensionExpr(yylex yyLexer, key ast.Expr, value ast.Expr, vars []string, rangeExpr ast.Expr, cond ast.Expr) ast.Expr {
+	if len(vars) < 1 {
+		yylex.Error("missing identifier")
+	} else if len(vars) > 2 {
+		yylex.Error("too many identifiers")
+	}
+	expr := &ast.ComprehensionExpr{Key: key, Value: value, Vars: vars, Range: rangeExpr, Cond: cond}
+	if l, ok := yylex.(*Lexer); ok {
+		expr.SetPosition(l.pos)
+	}
+	return expr
+}
+
+// newShortVarStmt returns the var statement of a := declaration, where each of the names must be an identifier.
+// Like assignments, a type assertion to two names also returns if it succeeded.
+func newShortVarStmt(yylex yyLexer, names []ast.Expr, exprs []ast.Expr) ast.Stmt {
+	stmt := &ast.VarStmt{Names: make([]string, 0, len(names)), Exprs: exprs}
+	stmt.SetPosition(names[0].Position())
+	for _, name := range names {
+		identExpr, ok := name.(*ast.IdentExpr)
+		if !ok {
+			yylex.Error("syntax error: unexpected ':='")
+			return stmt
+		}
+		stmt.Names = append(stmt.Names, identExpr.Lit)
+	}
+	if len(names) == 2 && len(exprs) == 1 {
+		if typeAssertExpr, ok := exprs[0].(*ast.TypeAssertExpr); ok {
+			typeAssertExpr.Ok = true
+		}
+	}
+	return stmt
+}
+
 func stringToValue(aString string) reflect.Value {
 	return reflect.ValueOf(aString)
 }
@@ -11,28 +11,37 @@ import (
 	"github.com/gbl08ma/anko/ast"
 )
 
-//line parser.go.y:43
+//line parser.go.y:57
 type yySymType struct {
 	yys int
 	tok ast.Token
 
-	compstmt            ast.Stmt
-	stmts               ast.Stmt
-	stmt                ast.Stmt
-	stmt_var_or_lets    ast.Stmt
-	stmt_var            ast.Stmt
-	stmt_lets           ast.Stmt
-	stmt_if             ast.Stmt
-	stmt_for            ast.Stmt
-	stmt_switch         ast.Stmt
-	stmt_switch_cases   ast.Stmt
-	stmt_switch_case    ast.Stmt
-	stmt_switch_default ast.Stmt
+	compstmt               ast.Stmt
+	stmts                  ast.Stmt
+	stmt                   ast.Stmt
+	stmt_var_or_lets       ast.Stmt
+	stmt_var               ast.Stmt
+	stmt_lets              ast.Stmt
+	stmt_if                ast.Stmt
+	stmt_for               ast.Stmt
+	stmt_switch            ast.Stmt
+	stmt_switch_cases      ast.Stmt
+	stmt_switch_case       ast.Stmt
+	stmt_switch_default    ast.Stmt
+	stmt_type_switch_cases ast.Stmt
+	stmt_type_switch_case  ast.Stmt
+	stmt_select            ast.Stmt
+	stmt_select_cases      ast.Stmt
+	stmt_select_case       ast.Stmt
+	stmt_select_default    ast.Stmt
+	stmt_try_catches       ast.Stmt
+	stmt_try_catch         ast.Stmt
 
 	exprs                []ast.Expr
 	expr                 ast.Expr
 	expr_idents          []string
 	type_data            *ast.TypeStruct
+	type_data_list       []*ast.TypeStruct
 	slice_count          int
 	expr_member_or_ident ast.Expr
 	expr_member          *ast.MemberExpr
@@ -58,52 +67,62 @@ const VARARG = 57350
 const FUNC = 57351
 const RETURN = 57352
 const VAR = 57353
-const THROW = 57354
-const IF = 57355
-const ELSE = 57356
-const FOR = 57357
-const IN = 57358
-const EQEQ = 57359
-const NEQ = 57360
-const GE = 57361
-const LE = 57362
-const OROR = 57363
-const ANDAND = 57364
-const NEW = 57365
-const TRUE = 57366
-const FALSE = 57367
-const NIL = 57368
-const NILCOALESCE = 57369
-const MODULE = 57370
-const TRY = 57371
-const CATCH = 57372
-const FINALLY = 57373
-const PLUSEQ = 57374
-const MINUSEQ = 57375
-const MULEQ = 57376
-const DIVEQ = 57377
-const ANDEQ = 57378
-const OREQ = 57379
-const BREAK = 57380
-const CONTINUE = 57381
-const PLUSPLUS = 57382
-const MINUSMINUS = 57383
-const SHIFTLEFT = 57384
-const SHIFTRIGHT = 57385
-const SWITCH = 57386
-const CASE = 57387
-const DEFAULT = 57388
-const GO = 57389
-const CHAN = 57390
-const MAKE = 57391
-const OPCHAN = 57392
-const TYPE = 57393
-const LEN = 57394
-const DELETE = 57395
-const CLOSE = 57396
-const MAP = 57397
-const IMPORT = 57398
-const UNARY = 57399
+const CONST = 57354
+const THROW = 57355
+const IF = 57356
+const ELSE = 57357
+const FOR = 57358
+const IN = 57359
+const EQEQ = 57360
+const NEQ = 57361
+const GE = 57362
+const LE = 57363
+const OROR = 57364
+const ANDAND = 57365
+const NEW = 57366
+const TRUE = 57367
+const FALSE = 57368
+const NIL = 57369
+const NILCOALESCE = 57370
+const OPTCHAIN = 57371
+const OPTINDEX = 57372
+const ARROW = 57373
+const ARROWBLOCK = 57374
+const MODULE = 57375
+const TRY = 57376
+const CATCH = 57377
+const FINALLY = 57378
+const PLUSEQ = 57379
+const MINUSEQ = 57380
+const MULEQ = 57381
+const DIVEQ = 57382
+const ANDEQ = 57383
+const OREQ = 57384
+const BREAK = 57385
+const CONTINUE = 57386
+const PLUSPLUS = 57387
+const MINUSMINUS = 57388
+const SHIFTLEFT = 57389
+const SHIFTRIGHT = 57390
+const SWITCH = 57391
+const CASE = 57392
+const DEFAULT = 57393
+const FALLTHROUGH = 57394
+const GO = 57395
+const CHAN = 57396
+const MAKE = 57397
+const OPCHAN = 57398
+const TYPE = 57399
+const LEN = 57400
+const DELETE = 57401
+const CLOSE = 57402
+const MAP = 57403
+const IMPORT = 57404
+const SELECT = 57405
+const DEFER = 57406
+const STRUCT = 57407
+const INTERPOLATEDSTRING = 57408
+const UNARY = 57409
 
 var yyToknames = [...]string{
 	"$end",
@@ -117,6 +136,7 @@ var yyToknames = [...]string{
 	"FUNC",
 	"RETURN",
 	"VAR",
+	"CONST",
 	"THROW",
 	"IF",
 	"ELSE",
@@ -133,6 +153,10 @@ var yyToknames = [...]string{
 	"FALSE",
 	"NIL",
 	"NILCOALESCE",
+	"OPTCHAIN",
+	"OPTINDEX",
+	"ARROW",
+	"ARROWBLOCK",
 	"MODULE",
 	"TRY",
 	"CATCH",
@@ -152,6 +176,7 @@ var yyToknames = [...]string{
 	"SWITCH",
 	"CASE",
 	"DEFAULT",
+	"FALLTHROUGH",
 	"GO",
 	"CHAN",
 	"MAKE",
@@ -162,6 +187,10 @@ var yyToknames = [...]string{
 	"CLOSE",
 	"MAP",
 	"IMPORT",
+	"SELECT",
+	"DEFER",
+	"STRUCT",
+	"INTERPOLATEDSTRING",
 	"'='",
 	"':'",
 	"'?'",
@@ -176,709 +205,982 @@ var yyToknames = [...]string{
 	"'%'",
 	"'&'",
 	"UNARY",
+	"'('",
+	"'.'",
+	"'['",
 	"'{'",
 	"'}'",
-	"'('",
 	"')'",
 	"','",
 	"';'",
-	"'['",
 	"']'",
-	"'.'",
 	"'!'",
 	"'\\n'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.go.y:1040
+//line parser.go.y:1531
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 2,
-	57, 55,
-	75, 55,
-	76, 5,
+	67, 87,
+	87, 87,
+	88, 5,
 	-2, 1,
-	-1, 23,
-	75, 56,
-	-2, 26,
-	-1, 27,
-	16, 93,
-	-2, 55,
-	-1, 66,
-	57, 55,
-	75, 55,
+	-1, 28,
+	87, 88,
+	-2, 34,
+	-1, 32,
+	17, 146,
+	-2, 87,
+	-1, 72,
+	67, 87,
+	87, 87,
 	-2, 5,
-	-1, 118,
-	16, 94,
-	75, 94,
-	-2, 107,
-	-1, 122,
-	4, 102,
-	48, 102,
-	55, 102,
-	-2, 67,
-	-1, 258,
-	72, 174,
-	78, 174,
-	-2, 166,
-	-1, 277,
-	72, 174,
-	-2, 166,
-	-1, 281,
-	1, 58,
-	8, 58,
-	45, 58,
-	46, 58,
-	57, 58,
-	58, 58,
-	72, 58,
-	74, 58,
-	75, 58,
-	76, 58,
-	78, 58,
-	81, 58,
-	-2, 105,
-	-1, 285,
-	1, 17,
-	45, 17,
-	46, 17,
-	72, 17,
-	76, 17,
-	81, 17,
-	-2, 72,
-	-1, 287,
-	1, 19,
-	45, 19,
-	46, 19,
-	72, 19,
-	76, 19,
-	81, 19,
-	-2, 74,
-	-1, 317,
-	72, 172,
-	78, 172,
-	-2, 167,
-	-1, 334,
-	1, 16,
-	45, 16,
-	46, 16,
-	72, 16,
-	76, 16,
-	81, 16,
-	-2, 71,
-	-1, 335,
-	1, 18,
-	45, 18,
-	46, 18,
-	72, 18,
-	76, 18,
-	81, 18,
-	-2, 73,
+	-1, 131,
+	8, 234,
+	-2, 229,
+	-1, 136,
+	17, 147,
+	87, 147,
+	-2, 172,
+	-1, 146,
+	4, 166,
+	54, 166,
+	61, 166,
+	65, 166,
+	-2, 111,
+	-1, 236,
+	8, 235,
+	-2, 232,
+	-1, 245,
+	87, 180,
+	-2, 60,
+	-1, 312,
+	8, 234,
+	-2, 229,
+	-1, 338,
+	85, 242,
+	89, 242,
+	-2, 234,
+	-1, 344,
+	85, 242,
+	-2, 234,
+	-1, 368,
+	1, 22,
+	50, 22,
+	51, 22,
+	85, 22,
+	88, 22,
+	91, 22,
+	-2, 119,
+	-1, 369,
+	1, 23,
+	50, 23,
+	51, 23,
+	85, 23,
+	88, 23,
+	91, 23,
+	-2, 120,
+	-1, 370,
+	1, 24,
+	50, 24,
+	51, 24,
+	85, 24,
+	88, 24,
+	91, 24,
+	-2, 119,
+	-1, 371,
+	1, 25,
+	50, 25,
+	51, 25,
+	85, 25,
+	88, 25,
+	91, 25,
+	-2, 120,
+	-1, 389,
+	8, 234,
+	-2, 229,
+	-1, 391,
+	8, 234,
+	-2, 229,
+	-1, 418,
+	85, 240,
+	89, 240,
+	-2, 235,
+	-1, 456,
+	8, 234,
+	-2, 229,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3742
-
-var yyAct = [...]int{
-
-	70, 114, 224, 23, 36, 259, 309, 85, 5, 310,
-	312, 311, 8, 8, 208, 71, 277, 258, 75, 7,
-	33, 122, 8, 8, 8, 368, 68, 112, 115, 119,
-	207, 87, 88, 208, 125, 132, 272, 273, 208, 141,
-	134, 208, 139, 82, 320, 208, 8, 83, 271, 86,
-	142, 318, 147, 208, 128, 1, 275, 208, 148, 149,
-	150, 151, 213, 131, 82, 211, 140, 23, 83, 201,
-	86, 226, 123, 316, 196, 145, 327, 157, 158, 359,
-	161, 162, 163, 126, 165, 167, 168, 68, 286, 197,
-	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 6, 284, 335, 49, 200, 334, 67,
-	264, 386, 203, 256, 321, 239, 314, 130, 292, 154,
-	69, 426, 126, 197, 129, 216, 218, 219, 145, 315,
-	197, 121, 225, 205, 68, 127, 124, 204, 128, 128,
-	144, 128, 283, 425, 287, 145, 131, 124, 128, 128,
-	236, 128, 209, 210, 133, 212, 421, 243, 145, 194,
-	155, 138, 220, 221, 137, 223, 130, 136, 135, 222,
-	285, 145, 77, 129, 76, 228, 265, 145, 417, 255,
-	197, 240, 145, 416, 127, 414, 408, 246, 407, 164,
-	250, 403, 253, 402, 401, 131, 257, 399, 391, 229,
-	120, 387, 383, 379, 377, 68, 237, 376, 268, 375,
-	372, 342, 329, 300, 297, 332, 290, 195, 282, 280,
-	276, 245, 128, 281, 205, 288, 230, 247, 85, 291,
-	206, 422, 254, 293, 159, 420, 263, 261, 390, 244,
-	215, 370, 304, 306, 248, 358, 357, 313, 153, 124,
-	73, 227, 87, 88, 98, 99, 9, 353, 322, 312,
-	311, 419, 231, 232, 326, 415, 10, 78, 299, 331,
-	68, 274, 262, 143, 101, 102, 103, 169, 95, 96,
-	97, 100, 333, 72, 61, 82, 128, 340, 228, 83,
-	62, 86, 160, 4, 117, 301, 63, 66, 349, 64,
-	328, 124, 47, 354, 46, 352, 124, 330, 351, 2,
-	260, 124, 45, 65, 44, 360, 364, 30, 367, 50,
-	29, 369, 308, 152, 22, 21, 20, 68, 260, 25,
-	373, 128, 24, 279, 3, 337, 0, 0, 350, 0,
-	0, 0, 0, 0, 341, 361, 0, 0, 343, 344,
-	0, 346, 0, 195, 392, 0, 0, 394, 0, 356,
-	0, 0, 317, 0, 0, 0, 0, 0, 319, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 371,
-	0, 317, 0, 0, 0, 0, 0, 225, 413, 412,
-	0, 378, 0, 380, 381, 0, 0, 0, 396, 384,
-	0, 0, 0, 388, 389, 0, 0, 195, 418, 195,
-	0, 0, 124, 0, 0, 0, 398, 0, 0, 355,
-	0, 0, 0, 260, 0, 0, 0, 0, 404, 0,
-	0, 405, 406, 0, 0, 0, 409, 0, 0, 35,
-	52, 53, 0, 0, 31, 13, 48, 14, 26, 0,
-	27, 0, 195, 0, 0, 0, 0, 0, 39, 54,
-	55, 56, 0, 15, 16, 0, 423, 0, 424, 0,
-	0, 0, 124, 11, 12, 0, 0, 0, 0, 28,
-	0, 0, 17, 0, 40, 41, 0, 37, 18, 19,
-	42, 38, 0, 0, 0, 0, 0, 0, 51, 0,
-	58, 60, 0, 0, 59, 0, 43, 0, 34, 0,
-	0, 0, 32, 0, 0, 57, 260, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 365, 366, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 363, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 0,
-	0, 0, 83, 362, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 339, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 0, 0, 0, 83, 338, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 325, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 0, 0, 82, 0, 0, 0,
-	83, 324, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 296, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 0, 0, 0, 83, 295, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 267,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 0, 0, 0, 83, 266,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 242, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 0,
-	0, 0, 83, 241, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 233, 234, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	79, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 198, 0, 82, 0, 0, 0,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 411, 0, 0, 83, 0, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 0, 0, 0, 83, 410,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 0,
-	0, 0, 83, 400, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 397, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 0, 0, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 0, 0, 82, 395, 0, 0,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 0, 0, 0, 83, 393, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 385,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 0, 0, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 382, 0, 82, 0,
-	0, 0, 83, 0, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 0, 0, 0, 83, 374, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 347, 0, 82, 0, 0, 0,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 345, 0,
-	82, 0, 0, 0, 83, 0, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 336, 0, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 0,
-	0, 307, 83, 0, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	302, 0, 82, 0, 0, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 298, 0, 82, 0, 0, 0,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 0, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 289, 0,
-	82, 0, 0, 0, 83, 0, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 278,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 0, 0, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 270,
-	0, 0, 83, 0, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 269, 0, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 0, 0, 82, 0, 0, 251,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 0, 238, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 0, 0, 0, 83, 0, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 235, 0, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 82, 214,
-	0, 0, 83, 0, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 89, 90, 92, 93, 94, 91, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	202, 0, 82, 0, 0, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 0, 0, 89, 90, 92, 93, 94,
-	91, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 193, 0, 82, 0, 0, 0,
-	83, 0, 86, 85, 104, 105, 109, 107, 111, 110,
-	0, 0, 0, 0, 81, 0, 0, 0, 0, 89,
-	90, 92, 93, 94, 91, 0, 0, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 79, 0, 80, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 0, 0, 0, 83, 0, 86, 85, 104, 105,
-	109, 107, 111, 110, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 89, 90, 92, 93, 94, 91, 0,
-	0, 87, 88, 98, 99, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 106, 108, 101, 102, 103, 0, 95, 96, 97,
-	100, 0, 0, 0, 82, 0, 0, 0, 83, 0,
-	86, 85, 104, 105, 109, 107, 111, 110, 0, 0,
-	0, 0, 81, 0, 0, 0, 0, 89, 90, 92,
-	93, 94, 91, 0, 0, 87, 88, 98, 99, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 106, 108, 101, 102, 103,
-	0, 95, 96, 97, 100, 0, 0, 0, 156, 0,
-	0, 0, 83, 0, 86, 85, 104, 105, 109, 107,
-	111, 110, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
-	88, 98, 99, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 106,
-	108, 101, 102, 103, 0, 95, 96, 97, 100, 0,
-	0, 0, 82, 0, 0, 0, 83, 0, 86, 85,
-	104, 105, 109, 107, 111, 110, 0, 0, 0, 0,
-	81, 0, 0, 118, 52, 53, 0, 0, 31, 0,
-	48, 0, 0, 87, 88, 98, 99, 0, 0, 0,
-	0, 0, 39, 54, 55, 56, 0, 0, 0, 0,
-	0, 0, 80, 106, 108, 101, 102, 103, 0, 95,
-	96, 97, 100, 0, 0, 0, 82, 0, 40, 41,
-	83, 37, 86, 0, 42, 38, 0, 0, 0, 0,
-	0, 0, 51, 0, 58, 60, 0, 0, 59, 0,
-	113, 0, 34, 0, 0, 116, 32, 0, 0, 57,
-	35, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 39,
-	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 35, 52, 53, 0, 0, 31,
-	0, 0, 0, 0, 0, 40, 41, 0, 37, 0,
-	0, 42, 38, 39, 54, 55, 56, 0, 0, 51,
-	0, 58, 60, 0, 0, 59, 0, 43, 0, 34,
-	35, 52, 53, 32, 323, 31, 57, 0, 0, 40,
-	41, 0, 37, 0, 0, 42, 38, 0, 0, 39,
-	54, 55, 56, 51, 0, 58, 60, 0, 0, 59,
-	0, 43, 0, 34, 35, 52, 53, 32, 294, 31,
-	57, 0, 0, 0, 0, 40, 41, 0, 37, 0,
-	0, 42, 38, 39, 54, 55, 56, 0, 0, 51,
-	0, 58, 60, 0, 0, 59, 0, 43, 0, 34,
-	0, 0, 252, 32, 0, 0, 57, 0, 0, 40,
-	41, 0, 37, 0, 0, 42, 38, 0, 217, 0,
-	0, 0, 0, 51, 0, 58, 60, 0, 0, 59,
-	0, 43, 0, 34, 35, 52, 53, 32, 0, 31,
-	57, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 39, 54, 55, 56, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 35, 52,
-	53, 0, 0, 31, 0, 0, 0, 0, 0, 40,
-	41, 0, 37, 0, 0, 42, 38, 39, 54, 55,
-	56, 0, 0, 51, 0, 58, 60, 0, 0, 59,
-	0, 43, 0, 34, 0, 0, 199, 32, 0, 0,
-	57, 0, 0, 40, 41, 0, 37, 0, 0, 42,
-	38, 0, 166, 0, 0, 0, 0, 51, 0, 58,
-	60, 0, 0, 59, 0, 43, 0, 34, 35, 52,
-	53, 32, 0, 31, 57, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 39, 54, 55,
-	56, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 35, 52, 53, 0, 0, 31, 0, 0,
-	0, 0, 0, 40, 41, 0, 37, 0, 0, 42,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 58,
-	60, 0, 0, 59, 0, 43, 0, 34, 35, 52,
-	53, 32, 0, 31, 57, 0, 0, 40, 41, 0,
-	37, 0, 0, 42, 38, 0, 0, 39, 54, 55,
-	56, 51, 0, 58, 60, 0, 0, 59, 0, 348,
-	0, 34, 35, 52, 53, 32, 0, 31, 57, 0,
-	0, 0, 0, 40, 41, 0, 37, 0, 0, 42,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 58,
-	60, 0, 0, 59, 0, 305, 0, 34, 35, 52,
-	53, 32, 0, 31, 57, 0, 0, 40, 41, 0,
-	37, 0, 0, 42, 38, 0, 0, 39, 54, 55,
-	56, 51, 0, 58, 60, 0, 0, 59, 0, 303,
-	0, 34, 0, 0, 0, 32, 0, 0, 57, 0,
-	0, 0, 0, 40, 41, 0, 37, 0, 0, 42,
-	38, 0, 85, 104, 105, 109, 107, 51, 110, 58,
-	60, 0, 0, 59, 0, 249, 0, 34, 0, 0,
-	0, 32, 0, 0, 57, 0, 87, 88, 98, 99,
+const yyLast = 5583
+
+var yyAct = [...]int16{
+	197, 574, 259, 28, 339, 156, 6, 572, 321, 4,
+	2, 196, 73, 72, 71, 78, 326, 82, 367, 319,
+	86, 88, 24, 104, 327, 54, 320, 146, 192, 8,
+	575, 322, 130, 133, 137, 99, 101, 191, 5, 144,
+	77, 8, 195, 8, 615, 344, 148, 148, 165, 8,
+	581, 105, 106, 116, 117, 579, 419, 171, 417, 323,
+	322, 8, 329, 328, 172, 173, 174, 175, 338, 262,
+	7, 8, 8, 28, 262, 413, 425, 74, 119, 120,
+	121, 359, 113, 114, 115, 118, 267, 98, 102, 100,
+	186, 187, 241, 331, 193, 177, 198, 199, 200, 148,
+	8, 204, 206, 8, 209, 210, 559, 477, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 434, 253, 578, 179, 240, 416, 179, 236, 541,
+	80, 201, 378, 297, 74, 148, 610, 148, 257, 468,
+	258, 151, 237, 412, 179, 93, 307, 542, 1, 262,
+	269, 271, 272, 356, 357, 611, 463, 599, 104, 177,
+	78, 330, 179, 132, 630, 148, 179, 179, 576, 78,
+	99, 101, 371, 297, 148, 177, 148, 370, 297, 292,
+	256, 536, 78, 476, 167, 279, 105, 106, 150, 369,
+	297, 155, 368, 297, 281, 94, 305, 41, 154, 301,
+	297, 254, 158, 294, 262, 151, 277, 295, 589, 74,
+	298, 297, 179, 152, 178, 285, 286, 287, 288, 473,
+	157, 169, 98, 102, 100, 634, 179, 262, 310, 151,
+	313, 411, 316, 181, 179, 302, 258, 459, 428, 153,
+	148, 168, 193, 262, 262, 410, 336, 383, 355, 633,
+	632, 345, 596, 340, 343, 155, 340, 437, 148, 628,
+	627, 352, 154, 624, 619, 148, 158, 616, 78, 361,
+	334, 362, 248, 609, 608, 603, 580, 152, 571, 155,
+	235, 372, 570, 566, 157, 554, 154, 552, 547, 546,
+	158, 377, 545, 360, 148, 379, 540, 74, 529, 263,
+	264, 152, 266, 99, 101, 390, 392, 528, 157, 236,
+	273, 274, 517, 276, 400, 402, 148, 510, 397, 426,
+	407, 506, 148, 504, 503, 502, 193, 394, 499, 283,
+	465, 460, 405, 421, 439, 418, 398, 438, 293, 401,
+	404, 418, 429, 296, 255, 408, 450, 262, 433, 493,
+	153, 153, 422, 153, 414, 98, 102, 100, 386, 188,
+	384, 153, 153, 149, 153, 151, 375, 374, 364, 309,
+	284, 614, 262, 448, 261, 441, 607, 605, 445, 424,
+	598, 333, 597, 308, 457, 337, 236, 311, 236, 588,
+	569, 148, 521, 397, 511, 498, 332, 495, 472, 470,
+	409, 74, 268, 147, 180, 153, 193, 140, 84, 157,
+	584, 398, 348, 265, 479, 155, 166, 531, 550, 481,
+	93, 340, 154, 488, 159, 491, 158, 484, 483, 189,
+	492, 262, 93, 93, 474, 519, 208, 152, 500, 151,
+	162, 93, 208, 439, 157, 93, 208, 153, 151, 203,
+	250, 164, 142, 236, 163, 160, 190, 246, 90, 516,
+	89, 387, 93, 153, 406, 255, 193, 399, 524, 242,
+	94, 527, 420, 9, 494, 324, 191, 587, 532, 74,
+	32, 534, 94, 184, 365, 78, 262, 575, 322, 155,
+	148, 182, 395, 262, 522, 94, 154, 567, 155, 92,
+	158, 275, 245, 523, 247, 154, 435, 475, 148, 158,
+	538, 152, 94, 514, 193, 161, 153, 560, 157, 318,
+	152, 148, 444, 207, 323, 322, 202, 157, 565, 141,
+	564, 415, 278, 335, 449, 329, 328, 451, 452, 251,
+	454, 280, 556, 282, 442, 440, 176, 91, 461, 582,
+	583, 10, 81, 530, 497, 466, 471, 153, 469, 585,
+	363, 340, 358, 347, 592, 249, 573, 83, 76, 148,
+	75, 67, 482, 68, 69, 485, 70, 604, 52, 148,
+	148, 51, 50, 49, 135, 36, 42, 496, 55, 593,
+	35, 594, 427, 617, 148, 366, 325, 27, 26, 23,
+	30, 29, 505, 3, 507, 508, 0, 317, 148, 525,
+	623, 512, 513, 0, 148, 592, 515, 0, 0, 518,
+	148, 520, 0, 153, 0, 346, 153, 0, 0, 573,
+	0, 0, 349, 0, 0, 0, 0, 0, 0, 0,
+	593, 0, 0, 0, 539, 0, 0, 543, 0, 0,
+	553, 0, 79, 57, 58, 0, 260, 37, 548, 0,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	555, 0, 45, 60, 61, 62, 0, 561, 0, 0,
+	0, 0, 0, 396, 0, 0, 0, 0, 568, 403,
+	0, 0, 0, 0, 0, 0, 0, 0, 323, 322,
+	577, 0, 376, 46, 47, 0, 43, 0, 423, 48,
+	44, 0, 153, 0, 59, 0, 0, 0, 590, 0,
+	0, 56, 595, 64, 66, 0, 0, 65, 153, 38,
+	0, 39, 40, 0, 0, 0, 0, 606, 63, 153,
+	0, 0, 0, 0, 0, 0, 0, 613, 0, 0,
+	0, 0, 0, 0, 618, 0, 620, 0, 458, 621,
+	0, 0, 0, 625, 0, 0, 0, 0, 0, 629,
+	25, 57, 58, 153, 0, 37, 14, 53, 15, 16,
+	31, 0, 32, 0, 0, 0, 0, 0, 0, 0,
+	45, 60, 61, 62, 0, 0, 0, 0, 0, 17,
+	18, 0, 0, 0, 0, 0, 0, 0, 0, 11,
+	12, 0, 0, 0, 0, 33, 0, 0, 13, 19,
+	153, 46, 47, 0, 43, 21, 22, 48, 44, 34,
+	20, 0, 59, 0, 0, 0, 0, 0, 0, 56,
+	0, 64, 66, 0, 0, 65, 0, 38, 0, 39,
+	40, 0, 0, 0, 0, 0, 63, 537, 602, 0,
+	0, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 549, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 557, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	0, 0, 0, 0, 0, 8, 591, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 600, 601, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 612, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 622, 0, 0, 0, 0,
+	0, 626, 0, 0, 0, 558, 0, 631, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 104, 122, 123, 127, 125,
+	129, 128, 8, 0, 0, 0, 97, 99, 101, 0,
+	0, 0, 0, 0, 0, 107, 108, 110, 111, 112,
+	109, 0, 0, 105, 106, 116, 117, 0, 0, 0,
+	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 124, 126,
+	119, 120, 121, 0, 113, 114, 115, 118, 0, 98,
+	102, 100, 104, 122, 123, 127, 125, 129, 128, 8,
+	0, 0, 0, 97, 99, 101, 0, 0, 0, 0,
+	0, 0, 107, 108, 110, 111, 112, 109, 0, 0,
+	105, 106, 116, 117, 0, 0, 0, 0, 0, 0,
+	0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 487, 96, 124, 126, 119, 120, 121,
+	0, 113, 114, 115, 118, 0, 98, 102, 100, 0,
+	0, 0, 0, 0, 486, 104, 122, 123, 127, 125,
+	129, 128, 0, 0, 0, 0, 97, 99, 101, 0,
+	0, 0, 0, 0, 0, 107, 108, 110, 111, 112,
+	109, 0, 0, 105, 106, 116, 117, 0, 0, 0,
+	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 447, 96, 124, 126,
+	119, 120, 121, 0, 113, 114, 115, 118, 0, 98,
+	102, 100, 0, 0, 0, 0, 0, 446, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 432,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 0, 0, 0, 0, 0,
+	431, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 382, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	0, 0, 0, 381, 104, 122, 123, 127, 125, 129,
+	128, 0, 0, 0, 0, 97, 99, 101, 0, 0,
+	0, 0, 0, 0, 107, 108, 110, 111, 112, 109,
+	0, 0, 105, 106, 116, 117, 0, 0, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 351, 96, 124, 126, 119,
+	120, 121, 0, 113, 114, 115, 118, 0, 98, 102,
+	100, 0, 0, 0, 0, 0, 350, 104, 122, 123,
+	127, 125, 129, 128, 0, 0, 0, 0, 97, 99,
+	101, 0, 0, 0, 0, 0, 0, 107, 108, 110,
+	111, 112, 109, 0, 0, 105, 106, 116, 117, 0,
+	0, 0, 0, 0, 0, 0, 103, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 304, 96,
+	124, 126, 119, 120, 121, 0, 113, 114, 115, 118,
+	0, 98, 102, 100, 0, 0, 0, 0, 0, 303,
+	104, 122, 123, 127, 125, 129, 128, 0, 0, 0,
+	0, 97, 99, 101, 0, 0, 0, 0, 0, 0,
+	107, 108, 110, 111, 112, 109, 0, 0, 105, 106,
+	116, 117, 0, 0, 0, 0, 0, 0, 0, 103,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 124, 126, 119, 120, 121, 0, 113,
+	114, 115, 118, 0, 98, 102, 100, 0, 0, 0,
+	0, 0, 562, 104, 122, 123, 127, 125, 129, 128,
+	0, 0, 0, 0, 97, 99, 101, 0, 0, 0,
+	0, 0, 0, 107, 108, 110, 111, 112, 109, 0,
+	0, 105, 106, 116, 117, 0, 0, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 124, 126, 119, 120,
+	121, 0, 113, 114, 115, 118, 0, 98, 102, 100,
+	0, 0, 0, 0, 0, 544, 104, 122, 123, 127,
+	125, 129, 128, 0, 0, 0, 0, 97, 99, 101,
+	0, 0, 0, 0, 0, 0, 107, 108, 110, 111,
+	112, 109, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 124,
+	126, 119, 120, 121, 0, 113, 114, 115, 118, 0,
+	98, 102, 100, 0, 0, 0, 0, 0, 533, 104,
+	122, 123, 127, 125, 129, 128, 0, 0, 0, 0,
+	97, 99, 101, 0, 0, 0, 0, 0, 0, 107,
+	108, 110, 111, 112, 109, 0, 0, 105, 106, 116,
+	117, 0, 0, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 124, 126, 119, 120, 121, 0, 113, 114,
+	115, 118, 0, 98, 102, 100, 0, 0, 0, 0,
+	0, 501, 104, 122, 123, 127, 125, 129, 128, 0,
+	0, 0, 0, 97, 99, 101, 0, 0, 0, 0,
+	0, 0, 107, 108, 110, 111, 112, 109, 0, 0,
+	105, 106, 116, 117, 0, 0, 0, 0, 0, 0,
+	0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 124, 126, 119, 120, 121,
+	0, 113, 114, 115, 118, 0, 98, 102, 100, 0,
+	0, 0, 0, 0, 306, 104, 122, 123, 127, 125,
+	129, 128, 0, 0, 0, 0, 97, 99, 101, 0,
+	0, 0, 0, 0, 0, 107, 108, 110, 111, 112,
+	109, 0, 0, 105, 106, 116, 117, 0, 0, 0,
+	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 124, 126,
+	119, 120, 121, 0, 113, 114, 115, 118, 0, 98,
+	102, 100, 0, 0, 489, 490, 104, 122, 123, 127,
+	125, 129, 128, 0, 0, 0, 0, 97, 99, 101,
+	0, 0, 0, 0, 0, 0, 107, 108, 110, 111,
+	112, 109, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 124,
+	126, 119, 120, 121, 0, 113, 114, 115, 118, 0,
+	98, 102, 100, 0, 0, 0, 0, 393, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 0, 0, 0, 0, 314,
+	104, 122, 123, 127, 125, 129, 128, 0, 0, 0,
+	0, 97, 99, 101, 0, 0, 0, 0, 0, 0,
+	107, 108, 110, 111, 112, 109, 0, 0, 105, 106,
+	116, 117, 0, 0, 0, 0, 0, 0, 0, 103,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 124, 126, 119, 120, 121, 0, 113,
+	114, 115, 118, 0, 98, 102, 100, 0, 0, 289,
+	290, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	563, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	535, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	443, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	354, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	353, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	291, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 0, 0,
+	252, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 238, 104,
+	122, 123, 127, 125, 129, 128, 0, 0, 0, 0,
+	97, 99, 101, 0, 0, 0, 0, 0, 0, 107,
+	108, 110, 111, 112, 109, 0, 0, 105, 106, 116,
+	117, 0, 0, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 124, 126, 119, 120, 121, 0, 113, 114,
+	115, 118, 0, 98, 102, 100, 509, 104, 122, 123,
+	127, 125, 129, 128, 0, 0, 0, 0, 97, 99,
+	101, 0, 0, 0, 0, 0, 0, 107, 108, 110,
+	111, 112, 109, 0, 0, 105, 106, 116, 117, 0,
+	0, 0, 0, 0, 0, 0, 103, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	124, 126, 119, 120, 121, 0, 113, 114, 115, 118,
+	0, 98, 102, 100, 455, 104, 122, 123, 127, 125,
+	129, 128, 0, 0, 0, 0, 97, 99, 101, 0,
+	0, 0, 0, 0, 0, 107, 108, 110, 111, 112,
+	109, 0, 0, 105, 106, 116, 117, 0, 0, 0,
+	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 124, 126,
+	119, 120, 121, 0, 113, 114, 115, 118, 0, 98,
+	102, 100, 453, 104, 122, 123, 127, 125, 129, 128,
+	0, 0, 0, 0, 97, 99, 101, 0, 0, 0,
+	0, 0, 0, 107, 108, 110, 111, 112, 109, 0,
+	0, 105, 106, 116, 117, 0, 0, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 124, 126, 119, 120,
+	121, 0, 113, 114, 115, 118, 0, 98, 102, 100,
+	388, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 385, 104,
+	122, 123, 127, 125, 129, 128, 0, 0, 0, 0,
+	97, 99, 101, 0, 0, 0, 0, 0, 0, 107,
+	108, 110, 111, 112, 109, 0, 0, 105, 106, 116,
+	117, 0, 0, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 124, 126, 119, 120, 121, 0, 113, 114,
+	115, 118, 299, 98, 102, 100, 373, 0, 0, 0,
+	341, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 244, 100, 243, 104, 122, 123, 127,
+	125, 129, 128, 0, 0, 0, 0, 97, 99, 101,
+	0, 0, 0, 0, 0, 0, 107, 108, 110, 111,
+	112, 109, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 124,
+	126, 119, 120, 121, 0, 113, 114, 115, 118, 0,
+	98, 102, 100, 234, 104, 122, 123, 127, 125, 129,
+	128, 0, 0, 0, 0, 97, 99, 101, 0, 0,
+	0, 0, 0, 0, 107, 108, 110, 111, 112, 109,
+	0, 0, 105, 106, 116, 117, 0, 0, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 586, 96, 124, 126, 119,
+	120, 121, 0, 113, 114, 115, 118, 0, 98, 102,
+	100, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 551, 96, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 526,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 478, 104, 122, 123, 127,
+	125, 129, 128, 0, 0, 0, 0, 97, 99, 101,
+	0, 0, 0, 0, 0, 0, 107, 108, 110, 111,
+	112, 109, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 124,
+	126, 119, 120, 121, 0, 113, 114, 115, 118, 0,
+	98, 102, 100, 104, 122, 123, 127, 125, 129, 128,
+	0, 0, 0, 0, 97, 99, 101, 0, 0, 0,
+	0, 0, 0, 107, 108, 110, 111, 112, 109, 0,
+	0, 105, 106, 116, 117, 0, 0, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 467, 96, 124, 126, 119, 120,
+	121, 0, 113, 114, 115, 118, 0, 98, 102, 100,
+	104, 122, 123, 127, 125, 129, 128, 0, 0, 0,
+	0, 97, 99, 101, 0, 0, 0, 0, 0, 0,
+	107, 108, 110, 111, 112, 109, 0, 0, 105, 106,
+	116, 117, 0, 0, 0, 0, 0, 0, 0, 103,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 106, 108, 101, 102,
-	103, 0, 95, 96, 97, 100, 35, 146, 53, 82,
-	0, 31, 0, 83, 0, 86, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 39, 54, 55, 56, 0,
+	0, 462, 96, 124, 126, 119, 120, 121, 0, 113,
+	114, 115, 118, 0, 98, 102, 100, 104, 122, 123,
+	127, 125, 129, 128, 0, 0, 0, 0, 97, 99,
+	101, 0, 0, 0, 0, 0, 0, 107, 108, 110,
+	111, 112, 109, 0, 0, 105, 106, 116, 117, 0,
+	0, 0, 0, 0, 0, 0, 103, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 436, 96,
+	124, 126, 119, 120, 121, 0, 113, 114, 115, 118,
+	0, 98, 102, 100, 104, 122, 123, 127, 125, 129,
+	128, 0, 0, 0, 0, 97, 99, 101, 0, 0,
+	0, 0, 0, 0, 107, 108, 110, 111, 112, 109,
+	0, 0, 105, 106, 116, 117, 0, 0, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 342, 96, 124, 126, 119,
+	120, 121, 0, 113, 114, 115, 118, 0, 98, 102,
+	100, 104, 122, 123, 127, 125, 129, 128, 0, 0,
+	0, 0, 97, 99, 101, 0, 0, 0, 0, 0,
+	0, 107, 108, 110, 111, 112, 109, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 300, 96, 124, 126, 119, 120, 121, 299,
+	113, 114, 115, 118, 0, 98, 102, 100, 104, 122,
+	123, 127, 125, 129, 128, 0, 0, 0, 0, 97,
+	99, 101, 0, 0, 0, 0, 0, 0, 107, 108,
+	110, 111, 112, 109, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 0, 0, 0, 103, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	74, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	0, 40, 41, 0, 37, 0, 0, 42, 38, 39,
-	54, 55, 56, 0, 0, 51, 0, 58, 60, 0,
-	0, 59, 0, 43, 0, 34, 0, 0, 0, 32,
-	0, 0, 57, 0, 0, 40, 41, 0, 37, 0,
-	0, 42, 38, 85, 104, 105, 109, 107, 0, 51,
-	0, 58, 60, 0, 0, 59, 0, 43, 85, 34,
-	0, 0, 0, 32, 0, 0, 57, 87, 88, 98,
-	99, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 88, 98, 99, 0, 106, 108, 101,
-	102, 103, 0, 95, 96, 97, 100, 0, 0, 0,
-	82, 0, 0, 0, 83, 0, 86, 0, 95, 96,
-	97, 100, 0, 0, 0, 82, 0, 0, 0, 83,
-	0, 86,
+	96, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 104, 122, 123, 127, 125,
+	129, 128, 0, 0, 0, 0, 97, 99, 101, 0,
+	0, 0, 0, 0, 0, 107, 108, 110, 111, 112,
+	109, 0, 0, 105, 106, 116, 117, 0, 0, 0,
+	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 96, 124, 126,
+	119, 120, 121, 0, 113, 114, 115, 118, 0, 98,
+	102, 100, 104, 122, 123, 127, 125, 129, 128, 0,
+	0, 0, 0, 97, 99, 101, 0, 0, 0, 0,
+	0, 0, 107, 108, 110, 111, 112, 109, 0, 0,
+	105, 106, 116, 117, 0, 0, 0, 0, 0, 0,
+	0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 124, 126, 119, 120, 121,
+	0, 113, 114, 115, 118, 0, 98, 102, 100, 104,
+	122, 123, 127, 125, 129, 128, 0, 0, 0, 0,
+	97, 99, 101, 0, 0, 0, 0, 0, 0, 107,
+	108, 110, 111, 112, 109, 0, 0, 105, 106, 116,
+	117, 0, 0, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 124, 126, 119, 120, 121, 0, 113, 114,
+	115, 118, 0, 98, 464, 100, 104, 122, 123, 127,
+	125, 129, 128, 0, 0, 0, 0, 97, 99, 101,
+	0, 0, 0, 0, 0, 0, 107, 108, 110, 111,
+	112, 109, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 124,
+	126, 119, 120, 121, 0, 113, 114, 115, 118, 0,
+	185, 102, 100, 104, 122, 123, 127, 125, 129, 128,
+	0, 0, 0, 0, 97, 99, 101, 0, 0, 0,
+	0, 0, 0, 107, 108, 110, 111, 112, 109, 0,
+	0, 105, 106, 116, 117, 0, 0, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 96, 124, 126, 119, 120,
+	121, 0, 113, 114, 115, 118, 0, 183, 102, 100,
+	136, 57, 58, 0, 0, 37, 0, 53, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	45, 60, 61, 62, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 57, 58, 0, 260, 37, 0,
+	0, 46, 47, 0, 43, 0, 0, 48, 44, 0,
+	0, 0, 59, 45, 60, 61, 62, 0, 0, 56,
+	0, 64, 66, 0, 0, 65, 0, 38, 0, 39,
+	131, 0, 0, 0, 134, 0, 63, 0, 79, 57,
+	58, 0, 0, 37, 46, 47, 0, 43, 0, 0,
+	48, 44, 0, 0, 0, 59, 0, 0, 45, 60,
+	61, 62, 56, 0, 64, 66, 194, 0, 65, 0,
+	38, 0, 39, 40, 0, 0, 0, 0, 0, 63,
+	0, 79, 57, 58, 0, 0, 37, 0, 0, 46,
+	47, 0, 43, 0, 0, 48, 44, 0, 0, 0,
+	59, 45, 60, 61, 62, 0, 0, 56, 0, 64,
+	66, 0, 0, 65, 0, 38, 0, 39, 40, 0,
+	0, 0, 0, 0, 63, 79, 57, 58, 0, 480,
+	37, 0, 46, 47, 0, 43, 0, 0, 48, 44,
+	0, 0, 158, 59, 0, 45, 60, 61, 62, 0,
+	56, 0, 64, 66, 0, 0, 65, 0, 38, 0,
+	39, 40, 0, 0, 0, 0, 0, 63, 79, 57,
+	58, 0, 0, 37, 0, 0, 46, 47, 0, 43,
+	0, 0, 48, 44, 0, 0, 0, 59, 45, 60,
+	61, 62, 0, 0, 56, 0, 64, 66, 0, 0,
+	65, 0, 38, 0, 39, 40, 0, 0, 0, 0,
+	0, 63, 79, 57, 58, 0, 0, 37, 0, 46,
+	47, 0, 43, 0, 0, 48, 44, 0, 0, 0,
+	59, 0, 45, 60, 61, 62, 0, 56, 0, 64,
+	66, 0, 0, 65, 0, 38, 0, 39, 40, 0,
+	0, 0, 0, 430, 63, 79, 57, 58, 0, 0,
+	37, 0, 0, 46, 47, 0, 43, 0, 0, 48,
+	44, 0, 0, 0, 59, 45, 60, 61, 62, 0,
+	0, 56, 0, 64, 66, 0, 0, 65, 0, 38,
+	0, 39, 40, 0, 0, 0, 0, 380, 63, 79,
+	57, 58, 0, 0, 37, 0, 46, 47, 0, 43,
+	0, 0, 48, 44, 0, 0, 0, 59, 0, 45,
+	60, 61, 62, 0, 56, 0, 64, 66, 0, 0,
+	65, 0, 38, 0, 39, 40, 0, 0, 0, 315,
+	0, 63, 0, 0, 79, 57, 58, 0, 0, 37,
+	46, 47, 0, 43, 0, 0, 48, 44, 0, 0,
+	0, 59, 0, 270, 45, 60, 61, 62, 56, 0,
+	64, 66, 0, 0, 65, 0, 38, 0, 39, 40,
+	0, 0, 0, 0, 0, 63, 0, 79, 57, 58,
+	0, 0, 37, 0, 0, 46, 47, 0, 43, 0,
+	0, 48, 44, 0, 0, 0, 59, 45, 60, 61,
+	62, 0, 0, 56, 0, 64, 66, 0, 0, 65,
+	0, 38, 0, 39, 40, 0, 0, 0, 239, 0,
+	63, 0, 145, 57, 58, 0, 0, 37, 46, 47,
+	0, 43, 0, 0, 48, 44, 0, 0, 0, 59,
+	0, 205, 45, 60, 61, 62, 56, 0, 64, 66,
+	0, 0, 65, 0, 38, 0, 39, 40, 0, 0,
+	0, 0, 0, 63, 0, 79, 57, 58, 0, 0,
+	37, 0, 0, 46, 47, 0, 43, 0, 0, 48,
+	44, 0, 0, 0, 59, 45, 60, 61, 62, 0,
+	0, 56, 0, 64, 66, 0, 0, 65, 0, 38,
+	0, 39, 40, 0, 143, 0, 0, 0, 63, 79,
+	57, 58, 0, 0, 37, 0, 46, 47, 0, 43,
+	0, 0, 48, 44, 0, 0, 0, 59, 0, 45,
+	60, 61, 62, 0, 56, 0, 64, 66, 0, 0,
+	65, 0, 38, 0, 39, 40, 0, 0, 0, 0,
+	0, 63, 79, 57, 58, 0, 0, 37, 0, 0,
+	46, 47, 0, 43, 0, 0, 48, 44, 0, 0,
+	0, 59, 45, 60, 61, 62, 0, 0, 56, 0,
+	64, 66, 0, 0, 65, 0, 38, 0, 39, 456,
+	0, 0, 0, 0, 0, 63, 79, 57, 58, 0,
+	0, 37, 0, 46, 47, 0, 43, 0, 0, 48,
+	44, 0, 0, 0, 59, 0, 45, 60, 61, 62,
+	0, 56, 0, 64, 66, 0, 0, 65, 0, 38,
+	0, 39, 391, 0, 0, 0, 0, 0, 63, 79,
+	57, 58, 0, 0, 37, 0, 0, 46, 47, 0,
+	43, 0, 0, 48, 44, 0, 0, 0, 59, 45,
+	60, 61, 62, 0, 0, 56, 0, 64, 66, 0,
+	0, 65, 0, 38, 0, 39, 389, 0, 0, 0,
+	0, 0, 63, 79, 170, 58, 0, 0, 37, 0,
+	46, 47, 0, 43, 0, 0, 48, 44, 0, 0,
+	0, 59, 0, 45, 60, 61, 62, 0, 56, 0,
+	64, 66, 0, 0, 65, 0, 38, 0, 39, 312,
+	0, 0, 0, 0, 0, 63, 139, 57, 58, 0,
+	0, 37, 0, 0, 46, 47, 0, 43, 0, 0,
+	48, 44, 0, 0, 0, 59, 45, 60, 61, 62,
+	0, 0, 56, 0, 64, 66, 0, 0, 65, 0,
+	38, 0, 39, 40, 0, 0, 0, 0, 0, 63,
+	87, 57, 58, 0, 0, 37, 0, 46, 47, 0,
+	43, 0, 0, 48, 44, 0, 0, 0, 59, 0,
+	45, 60, 61, 62, 0, 56, 0, 64, 66, 0,
+	0, 65, 0, 38, 0, 39, 138, 0, 0, 0,
+	0, 0, 63, 85, 57, 58, 0, 0, 37, 0,
+	0, 46, 47, 0, 43, 0, 0, 48, 44, 0,
+	0, 0, 59, 45, 60, 61, 62, 0, 0, 56,
+	0, 64, 66, 0, 0, 65, 0, 38, 0, 39,
+	40, 0, 0, 0, 0, 0, 63, 0, 0, 0,
+	0, 0, 0, 0, 46, 47, 0, 43, 0, 0,
+	48, 44, 0, 0, 0, 59, 0, 0, 0, 0,
+	0, 0, 56, 0, 64, 66, 0, 0, 65, 0,
+	38, 0, 39, 40, 0, 0, 0, 0, 0, 63,
+	104, 122, 123, 127, 125, 129, 128, 0, 0, 0,
+	0, 97, 99, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 105, 106,
+	116, 117, 0, 0, 0, 0, 0, 0, 0, 103,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 124, 126, 119, 120, 121, 0, 113,
+	114, 115, 118, 0, 98, 102, 100, 104, 122, 123,
+	127, 125, 129, 128, 0, 0, 0, 0, 97, 99,
+	101, 104, 122, 123, 127, 125, 0, 128, 0, 0,
+	0, 0, 0, 99, 101, 105, 106, 116, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 105,
+	106, 116, 117, 0, 0, 0, 0, 0, 0, 96,
+	124, 126, 119, 120, 121, 0, 113, 114, 115, 118,
+	0, 98, 102, 100, 124, 126, 119, 120, 121, 0,
+	113, 114, 115, 118, 0, 98, 102, 100, 104, 122,
+	123, 127, 125, 0, 0, 0, 104, 0, 0, 0,
+	99, 101, 0, 0, 0, 0, 0, 0, 99, 101,
+	0, 0, 0, 0, 0, 0, 105, 106, 116, 117,
+	0, 0, 0, 0, 105, 106, 116, 117, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 124, 126, 119, 120, 121, 0, 113, 114, 115,
+	118, 0, 98, 102, 100, 113, 114, 115, 118, 0,
+	98, 102, 100,
 }
-var yyPact = [...]int{
-
-	-68, -1000, 445, -68, -1000, -69, -69, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3324, 3324, 289, 189, 3606, 111, 109,
-	263, -1000, -1000, 2687, -1000, -1000, 3324, 2969, 3324, -1000,
-	-1000, 137, -57, 79, 3324, 91, -37, 105, 104, 101,
-	98, 3324, -11, -69, -1000, -1000, -1000, -1000, 279, 93,
-	-1000, 3572, -1000, -1000, -1000, -1000, -1000, 3324, 3324, 3324,
-	3324, -1000, -1000, -1000, -1000, -1000, 445, -69, -1000, 0,
-	2751, 2751, 187, -68, 97, 2815, 3324, 3324, 231, 3324,
-	3324, 3324, 3324, 3254, 3324, 3324, 283, -1000, -1000, 3324,
-	3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324,
-	3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324, 3324,
-	3324, 3324, 2623, -68, 58, 1023, 3220, -7, 91, 2559,
-	279, 74, -14, 3324, -69, -41, -1000, 79, 79, -12,
-	79, -16, 2495, 3324, 3150, 3324, 3324, 79, 128, 2879,
-	79, 3324, 14, -1000, 3324, -69, -1000, -30, -30, -30,
-	-30, -30, -1000, -68, 164, 3324, 3324, 959, 2431, 3324,
-	-68, 2751, 2367, 2943, 117, 895, 3324, 2879, -9, -1000,
-	2751, 2751, 2751, 2751, 2751, 2751, -9, -9, -9, -9,
-	-9, -9, 3662, 3662, 3662, 222, 222, 222, 222, 222,
-	222, 3647, 3506, -68, 159, -69, 3324, -69, -68, 3464,
-	2303, 3116, -69, 115, 279, -1000, -58, -69, 278, -65,
-	-65, 79, -65, -14, -1000, 112, 831, 3324, 2239, 2175,
-	-26, -38, 277, -22, -59, 2111, 3324, 0, 3324, 156,
-	122, 106, 80, -1000, 3324, -1000, 2047, 154, 3324, 54,
-	-1000, -1000, 3080, 767, 152, -1000, 1983, 274, 151, -68,
-	1919, 3428, 3394, 1855, 224, 186, 52, 65, -69, -27,
-	-69, 3324, -1000, -34, 50, -1000, -1000, 3046, 703, -1000,
-	-1000, -1000, -1000, 3324, 1, 79, 150, -69, 3324, 0,
-	2751, -37, -1000, 221, 44, -1000, 41, -1000, 1791, -68,
-	-1000, 2879, -1000, 639, -1000, -1000, 3324, -1000, -68, -1000,
-	-1000, 149, -68, -68, 1727, -68, 1663, 3358, -35, -1000,
-	-1000, 209, 3324, -68, 185, 184, 5, -69, -1000, -58,
-	79, -1000, 575, -1000, -1000, 3324, 511, 3324, -46, -1000,
-	3324, 2751, 180, -68, -1000, -1000, -1000, 148, -1000, 3324,
-	1599, 147, -1000, 145, 142, -68, 141, -68, -68, 1535,
-	140, -1000, -1000, -68, 1471, 63, 139, -68, -68, 177,
-	136, -65, -1000, 3324, 1407, -1000, 3324, 1343, -69, 1279,
-	-68, 135, -1000, 1215, -1000, -1000, -1000, -1000, 132, -1000,
-	131, 129, -68, -1000, -1000, -68, -68, -1000, 126, 124,
-	-68, -1000, 1151, -1000, 1087, -1000, 3324, 3324, 123, 244,
-	-1000, -1000, -1000, -1000, 121, -1000, -1000, -1000, -1000, 116,
-	-1000, -1000, -59, 2751, 240, 174, -1000, -1000, 94, 170,
-	-68, -1000, -68, 81, 59, -1000, -1000,
+
+var yyPact = [...]int16{
+	-50, -1000, 776, -50, -1000, -48, -48, -1000, -1000, -1000,
+	-1000, 576, 574, -1000, 4921, 558, 4921, 573, 334, 5269,
+	5226, 389, 387, 542, -1000, 441, -1000, -1000, 4048, -1000,
+	-1000, 4921, 4396, 5182, 333, -1000, -1000, 458, 4878, -62,
+	-48, 211, 351, 384, 444, 383, 380, 4921, 343, -1000,
+	-1000, -1000, -1000, 558, 164, -1000, 5139, -1000, -1000, -1000,
+	-1000, -1000, -1000, 4921, 4921, 4921, 4921, -1000, -1000, -1000,
+	-1000, -1000, 776, -48, -1000, -1000, -1000, 8, 4115, 424,
+	157, -1000, 4115, 330, -50, 420, 4316, 412, 4249, 4921,
+	4921, 355, -30, 4484, 4921, 4921, 4921, 4921, 4921, 455,
+	4833, 4921, 452, 4921, 4921, -1000, -1000, 4921, 4921, 4921,
+	4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921,
+	4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921, 4921,
+	3309, -50, 135, 2694, 4790, 4, 411, 3241, -48, 399,
+	-48, 571, 379, 518, 2624, 124, 336, 4921, -48, 4439,
+	300, -1000, 211, 211, 340, 211, -1000, -3, 328, 4745,
+	4921, 4921, -1000, 211, 454, 5343, 211, 149, -48, 4921,
+	-1000, 284, 284, 284, 284, 284, -1000, -48, 4921, -48,
+	-50, 295, 4921, 4921, 4921, 4921, 2133, 2554, 4921, -50,
+	474, 4921, -1000, 4115, -50, 134, -1000, 3981, 4115, 3914,
+	5410, 123, 4921, -1000, 1480, 4921, 1845, 211, -1000, 5343,
+	151, 4115, 4115, 4115, 4115, 4115, 4115, 151, 151, 151,
+	151, 151, 151, 5499, 5499, 5499, 6, 6, 6, 6,
+	6, 6, 5491, 5424, -50, 294, -48, 4921, -50, 5095,
+	2061, 4701, 419, -48, 448, 658, 418, 495, 85, 211,
+	558, 4484, 512, 170, 558, -1000, -19, 3174, 3847, -42,
+	4921, -48, 569, 359, 359, 211, 359, 336, -48, 1407,
+	4921, 2484, 2414, 172, 77, 568, -8, 4921, 4921, 8,
+	4921, 8, 566, 293, 459, 116, 113, 101, 96, -1000,
+	4921, -1000, 3102, 292, -1000, 8, 291, -48, -1000, -1000,
+	4921, -1000, 56, -1000, 4658, 1334, -1000, 171, 285, -1000,
+	3034, 283, -50, 2966, 5052, 5008, 1989, 484, 445, 9,
+	-1000, -1000, 409, 4921, 4921, 12, -1000, -1000, 406, 4921,
+	326, 169, 155, 67, -1000, 4484, 510, 50, -48, -33,
+	-48, 558, 4921, 277, -48, 4115, 4921, -1000, -13, 244,
+	-1000, 4614, 1261, -1000, -1000, -1000, -1000, 4921, 44, 211,
+	8, 3780, 4115, -1000, -1000, 263, 519, -1000, -1000, -1000,
+	-1000, -1000, 2344, -50, -1000, -1000, 4921, 5343, -1000, 1188,
+	-1000, -1000, 4921, -1000, -1000, -50, -1000, 271, -50, -50,
+	2898, -50, 2830, 4965, 9, 161, 256, -1000, -1000, -50,
+	3713, 98, 4182, 255, -1000, -1000, -50, 3646, 82, -50,
+	325, 562, 324, 143, -1000, 4484, 486, 107, -48, -1000,
+	90, 3579, -1000, 4571, -19, 211, -1000, -50, 211, 1115,
+	-1000, -1000, 4921, 1918, 4527, 275, 417, 323, -50, 560,
+	321, -1000, 372, -1000, 253, -1000, -1000, 4921, 1772, 250,
+	-1000, 249, 248, -50, 246, -50, -50, 2762, 242, 320,
+	-1000, -1000, -50, -50, 442, -1000, -1000, -50, 4921, 237,
+	-50, 364, -50, 318, -1000, 4484, 482, 4921, 558, 3511,
+	4921, 232, 359, 223, 559, 421, -1000, 4921, 1699, -1000,
+	4921, 2274, 105, -48, 4921, -50, 221, 71, -50, -1000,
+	1626, -1000, -1000, -1000, -1000, 217, -1000, 214, 213, -50,
+	-1000, -48, -1000, -1000, 371, -1000, 3444, -1000, 212, 558,
+	210, -50, -1000, 4484, 981, 89, 4921, 4115, -1000, -1000,
+	211, -1000, 1553, -1000, 2204, -1000, -1000, 4439, 8, 208,
+	471, 211, 316, 207, -1000, -1000, -1000, -1000, 203, 447,
+	92, -50, -1000, 47, -1000, 201, -1000, -39, 4921, 4921,
+	4115, 414, -1000, -1000, -42, 3377, 451, 315, 132, -50,
+	-1000, -1000, -20, -1000, -1000, 235, 308, -1000, 306, 81,
+	-1000, -1000, 1048, 854, -1000, 200, 4921, 303, -50, 302,
+	199, 198, -1000, -1000, 78, 359, -1000, -48, -50, 297,
+	-45, 192, 4921, -1000, 4115, -50, 189, -50, -1000, -1000,
+	-50, -48, 447, 188, -50, -1000, -1000, 1048, 185, -1000,
+	184, -1000, 147, -20, -1000, 175, 174, -1000, -1000, 359,
+	-1000, 150, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 55, 344, 266, 276, 342, 339, 336, 335, 334,
-	332, 9, 6, 116, 0, 1, 34, 20, 330, 329,
-	4, 327, 2, 324, 322, 314, 312, 309, 306, 300,
-	294, 319, 303, 39, 5, 113, 19,
+var yyPgo = [...]int16{
+	0, 158, 613, 483, 561, 611, 610, 609, 22, 608,
+	19, 8, 26, 7, 1, 607, 606, 24, 16, 605,
+	18, 25, 42, 11, 0, 140, 156, 5, 602, 601,
+	207, 600, 598, 596, 595, 2, 593, 592, 591, 588,
+	28, 586, 584, 583, 581, 10, 9, 373, 4, 6,
+	70,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]int8{
 	0, 1, 1, 2, 2, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 4, 4, 5,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 4, 4, 5, 5, 5,
 	6, 6, 7, 7, 7, 8, 8, 8, 8, 8,
-	8, 8, 8, 8, 8, 8, 9, 10, 10, 10,
-	10, 10, 11, 11, 12, 13, 13, 13, 13, 14,
-	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 15, 15, 15, 16, 16, 16, 16,
-	16, 16, 17, 17, 18, 18, 19, 20, 21, 21,
-	21, 21, 21, 21, 22, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 24,
-	24, 24, 25, 25, 25, 25, 26, 26, 26, 26,
-	26, 26, 26, 26, 30, 30, 30, 30, 30, 30,
-	29, 29, 29, 28, 28, 28, 28, 28, 28, 27,
-	27, 31, 31, 32, 32, 32, 33, 33, 35, 35,
-	36, 34, 34, 34, 34,
+	8, 8, 8, 8, 8, 8, 9, 9, 9, 9,
+	10, 10, 10, 10, 10, 11, 11, 12, 13, 13,
+	13, 13, 13, 14, 19, 19, 20, 20, 15, 16,
+	16, 16, 16, 16, 17, 17, 18, 21, 21, 21,
+	22, 22, 22, 23, 23, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 40, 40, 25, 25, 25, 26,
+	26, 26, 26, 26, 26, 26, 27, 27, 28, 28,
+	28, 28, 29, 29, 29, 29, 30, 30, 31, 31,
+	32, 32, 33, 34, 34, 34, 34, 34, 34, 34,
+	35, 35, 35, 35, 35, 36, 36, 36, 36, 36,
+	36, 36, 36, 36, 36, 37, 37, 37, 37, 37,
+	38, 38, 38, 38, 39, 39, 39, 39, 39, 39,
+	39, 39, 44, 44, 44, 44, 44, 44, 43, 43,
+	43, 42, 42, 42, 42, 42, 42, 41, 41, 45,
+	45, 46, 46, 46, 47, 47, 49, 49, 50, 48,
+	48, 48, 48,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 1, 2, 2, 3, 0, 1, 1, 1, 2,
-	2, 5, 13, 12, 9, 8, 6, 5, 6, 5,
-	4, 6, 4, 1, 1, 1, 1, 1, 1, 4,
+	2, 1, 2, 4, 2, 5, 13, 12, 9, 8,
+	9, 5, 5, 5, 5, 5, 4, 6, 4, 1,
+	1, 4, 1, 1, 1, 1, 1, 4, 4, 7,
 	3, 3, 5, 7, 5, 4, 7, 5, 6, 7,
-	7, 8, 7, 8, 8, 9, 7, 0, 1, 1,
-	2, 2, 4, 4, 3, 0, 1, 4, 4, 1,
-	1, 5, 3, 7, 8, 8, 9, 2, 5, 7,
-	3, 5, 4, 5, 4, 4, 4, 4, 4, 4,
-	4, 6, 8, 7, 3, 2, 3, 10, 5, 1,
-	1, 1, 1, 0, 1, 4, 1, 3, 2, 2,
-	5, 2, 2, 3, 1, 1, 3, 1, 2, 1,
-	1, 1, 1, 1, 0, 3, 6, 6, 5, 5,
-	7, 8, 6, 5, 5, 7, 8, 2, 2, 2,
-	2, 2, 1, 1, 1, 1, 2, 2, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	7, 8, 7, 8, 8, 9, 7, 6, 14, 11,
+	0, 1, 1, 2, 2, 4, 4, 3, 0, 1,
+	1, 2, 2, 4, 1, 2, 9, 7, 6, 0,
+	1, 1, 2, 2, 4, 6, 3, 0, 1, 4,
+	0, 1, 4, 1, 2, 1, 1, 5, 3, 7,
+	8, 8, 9, 12, 13, 3, 4, 5, 6, 7,
+	8, 2, 5, 9, 11, 11, 13, 7, 3, 4,
+	4, 5, 4, 4, 4, 4, 4, 2, 4, 4,
+	6, 8, 7, 7, 5, 3, 2, 3, 10, 5,
+	1, 1, 1, 1, 1, 3, 0, 1, 4, 1,
+	3, 2, 2, 5, 2, 1, 4, 6, 2, 3,
+	4, 5, 1, 1, 4, 4, 2, 3, 1, 1,
+	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
+	0, 3, 6, 2, 5, 6, 5, 5, 7, 8,
+	6, 5, 5, 7, 8, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 2, 2, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 0, 1, 2, 1, 1, 0, 1, 1, 2,
-	1, 0, 2, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 0,
+	1, 2, 1, 1, 0, 1, 1, 2, 1, 0,
+	2, 1, 1,
 }
-var yyChk = [...]int{
-
-	-1000, -1, -31, -2, -32, 76, -35, -36, 81, -3,
-	-4, 38, 39, 10, 12, 28, 29, 47, 53, 54,
-	-7, -8, -9, -14, -5, -6, 13, 15, 44, -18,
-	-21, 9, 77, -17, 73, 4, -20, 52, 56, 23,
-	49, 50, 55, 71, -23, -24, -25, -26, 11, -13,
-	-19, 63, 5, 6, 24, 25, 26, 80, 65, 69,
-	66, -30, -29, -28, -27, -31, -32, -35, -36, -13,
-	-14, -14, 4, 71, 4, -14, 73, 73, 14, 57,
-	59, 27, 73, 77, 50, 16, 79, 40, 41, 32,
-	33, 37, 34, 35, 36, 66, 67, 68, 42, 43,
-	69, 62, 63, 64, 17, 18, 60, 20, 61, 19,
-	22, 21, -14, 71, -15, -14, 76, -4, 4, -14,
-	73, 4, 78, -33, -35, -16, 4, 66, -17, 55,
-	48, 77, -14, 73, 77, 73, 73, 73, 73, -14,
-	77, -33, -15, 4, 57, 75, 5, -14, -14, -14,
-	-14, -14, -3, 71, -1, 73, 73, -14, -14, 13,
-	71, -14, -14, -14, -13, -14, 58, -14, -14, 4,
-	-14, -14, -14, -14, -14, -14, -14, -14, -14, -14,
-	-14, -14, -14, -14, -14, -14, -14, -14, -14, -14,
-	-14, -14, -14, 71, -1, -35, 16, 75, 71, 76,
-	-14, 76, 71, -15, 73, -17, -13, 71, 79, -16,
-	-16, 77, -16, 78, 74, -13, -14, 58, -14, -14,
-	-16, -16, 51, -16, -22, -14, 57, -13, -33, -1,
-	72, -13, -13, 74, 75, 74, -14, -1, 58, 8,
-	74, 78, 58, -14, -1, 72, -14, -33, -1, 71,
-	-14, 76, 76, -14, -33, 74, 8, -15, 75, -34,
-	-35, -33, 4, -16, 8, 74, 78, 58, -14, 74,
-	74, 74, 74, 75, 4, 78, -34, 75, 58, -13,
-	-14, -20, 72, 30, 8, 74, 8, 74, -14, 71,
-	72, -14, 74, -14, 78, 78, 58, 72, 71, 4,
-	72, -1, 71, 71, -14, 71, -14, 76, -10, -12,
-	-11, 46, 45, 71, 74, 74, 8, -35, 78, -13,
-	78, 74, -14, 78, 78, 58, -14, 75, -16, 72,
-	-33, -14, 4, 71, 74, 74, 74, -1, 78, 58,
-	-14, -1, 72, -1, -1, 71, -1, 71, 71, -14,
-	-33, -11, -12, 58, -14, -13, -1, 71, 71, 74,
-	-34, -16, 78, 58, -14, 74, 75, -14, 71, -14,
-	71, -1, 72, -14, 78, 72, 72, 72, -1, 72,
-	-1, -1, 71, 72, -1, 58, 58, 72, -1, -1,
-	71, 72, -14, 78, -14, 74, -33, 58, -1, 72,
-	78, 72, 72, 72, -1, -1, -1, 72, 72, -1,
-	78, 74, -22, -14, 72, 31, 72, 72, -34, 31,
-	71, 72, 71, -1, -1, 72, 72,
+
+var yyChk = [...]int16{
+	-1000, -1, -45, -2, -46, 88, -49, -50, 91, -3,
+	-4, 43, 44, 52, 10, 12, 13, 33, 34, 53,
+	64, 59, 60, -7, -8, 4, -9, -15, -24, -5,
+	-6, 14, 16, 49, 63, -31, -34, 9, 81, 83,
+	84, -30, -33, 58, 62, 24, 55, 56, 61, -36,
+	-37, -38, -39, 11, -21, -32, 73, 5, 6, 66,
+	25, 26, 27, 90, 75, 79, 76, -44, -43, -42,
+	-41, -45, -46, -49, -50, 4, 4, -21, -24, 4,
+	-25, 4, -24, 4, 84, 4, -24, 4, -24, 81,
+	81, 15, 68, 31, 81, 67, 69, 28, 81, 29,
+	83, 30, 82, 56, 17, 45, 46, 37, 38, 42,
+	39, 40, 41, 76, 77, 78, 47, 48, 79, 72,
+	73, 74, 18, 19, 70, 21, 71, 20, 23, 22,
+	-24, 84, -25, -24, 88, -4, 4, -24, 84, 4,
+	84, 81, 4, 86, -24, 4, 89, -47, -49, -47,
+	-26, 4, 76, -30, 61, 54, -27, 83, 65, 83,
+	81, 81, 6, 81, 81, -24, 83, -25, 87, 67,
+	5, -24, -24, -24, -24, -24, -3, 87, 67, 87,
+	84, -1, 81, 81, 81, 81, -24, -24, 14, 84,
+	-47, 67, -40, -24, 32, -22, -23, -24, -24, -24,
+	-24, -22, 81, 4, -24, 68, -24, 81, 4, -24,
+	-24, -24, -24, -24, -24, -24, -24, -24, -24, -24,
+	-24, -24, -24, -24, -24, -24, -24, -24, -24, -24,
+	-24, -24, -24, -24, 84, -1, -49, 17, 84, 88,
+	-24, 88, 68, 84, 82, -47, 68, -47, -25, 4,
+	81, 31, 86, 8, 87, -30, -22, -24, -24, -35,
+	8, 84, 82, -26, -26, 83, -26, 89, 84, -24,
+	68, -24, -24, -26, -26, 57, -26, 67, -47, -21,
+	-47, -21, -47, -1, 85, -22, -22, -22, -22, 86,
+	87, 86, -24, -1, -8, -21, -1, 87, 86, 8,
+	68, 86, -22, 89, 68, -24, 89, -26, -1, 85,
+	-24, -1, 84, -24, 88, 88, -24, -47, 81, -10,
+	-12, -11, 51, 50, 67, -16, -18, -17, 51, 50,
+	86, 8, -26, -25, -40, 31, 86, -25, 87, -48,
+	-49, 16, 68, -48, 87, -24, -47, 4, -26, -47,
+	89, 68, -24, 86, 86, 86, 86, 87, 4, 89,
+	-21, -24, -24, 4, 85, 35, -19, -20, 86, 86,
+	86, 86, -24, 84, 85, 85, -47, -24, 86, -24,
+	89, 89, 68, 86, 85, 84, 85, -1, 84, 84,
+	-24, 84, -24, 88, -10, 57, -47, -11, -12, 68,
+	-24, -21, -24, -47, -17, -18, 68, -24, -21, 84,
+	86, 86, 86, 8, -40, 31, 86, 8, -49, 89,
+	-25, -24, 85, -47, -22, 89, 85, -28, 4, -24,
+	89, 89, 68, -24, 87, -26, 68, 4, 84, 81,
+	36, -20, 35, 86, -1, -23, 89, 68, -24, -1,
+	85, -1, -1, 84, -1, 84, 84, -24, -47, 86,
+	85, -1, 68, 68, 82, 85, -1, 68, 67, -1,
+	84, 4, 84, 86, -40, 31, 86, 17, 16, -24,
+	8, -48, -26, -45, -46, -26, 89, 68, -24, 86,
+	87, -24, -27, 84, 67, 84, -1, 4, 84, 85,
+	-24, 89, 85, 85, 85, -1, 85, -1, -1, 84,
+	85, 84, -1, -1, 81, -1, -24, 85, -1, 81,
+	-1, 84, -40, 31, -24, -25, 68, -24, 85, 85,
+	4, 6, -24, 89, -24, 86, 86, -47, -21, -1,
+	85, 68, 86, -1, 89, 85, 85, 85, -1, -47,
+	57, 68, 85, -25, 85, -1, -40, -47, 14, 17,
+	-24, -26, 89, 86, -35, -24, 85, 36, -26, 84,
+	85, 85, -13, -12, -14, 50, 86, -1, 86, 8,
+	85, 89, -24, -24, 6, -48, 68, 36, 84, 86,
+	-1, -47, -14, -12, -29, -26, 27, 84, 84, 86,
+	-47, -47, 14, 85, -24, 84, -1, 84, 85, 85,
+	68, 87, -47, -1, 84, 89, 85, -24, -1, 85,
+	-1, -1, -47, -13, 85, -1, -47, 85, 85, -26,
+	27, -47, 85, 85, 85,
 }
-var yyDef = [...]int{
-
-	161, -2, -2, 161, 162, 165, 164, 168, 170, 3,
-	6, 7, 8, 55, 0, 0, 0, 0, 0, 0,
-	23, 24, 25, -2, 27, 28, 0, -2, 0, 59,
-	60, 0, 166, 0, 0, 107, 105, 0, 0, 0,
-	0, 0, 0, 166, 89, 90, 91, 92, 93, 0,
-	104, 0, 109, 110, 111, 112, 113, 0, 0, 0,
-	0, 132, 133, 134, 135, 2, -2, 163, 169, 9,
-	56, 10, 0, 161, 107, 0, 0, 0, 0, 0,
-	0, 0, 55, 0, 0, 0, 0, 136, 137, 0,
+
+var yyDef = [...]int16{
+	229, -2, -2, 229, 230, 233, 232, 236, 238, 3,
+	6, 7, 8, 11, 87, 146, 0, 0, 0, 0,
+	0, 0, 0, 29, 30, 172, 32, 33, -2, 35,
+	36, 0, -2, 0, 0, 95, 96, 0, 0, 234,
+	234, 0, 169, 0, 0, 0, 0, 0, 0, 140,
+	141, 142, 143, 146, 0, 168, 0, 174, 175, 176,
+	177, 178, 179, 0, 0, 0, 0, 200, 201, 202,
+	203, 2, -2, 231, 237, 9, 10, 12, 88, 172,
+	0, 147, 14, 0, 229, 172, 0, 172, 0, 0,
+	0, 0, 234, 0, 90, 0, 0, 0, 90, 0,
+	0, 0, 0, 0, 0, 204, 205, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 56, 0, 0, -2, 0,
-	93, 0, -2, 55, 167, 0, 96, 0, 0, 0,
-	0, 0, 0, 55, 0, 0, 0, 0, 0, 85,
-	0, 114, 0, 94, 55, 166, 108, 127, 128, 129,
-	130, 131, 4, 161, 0, 55, 55, 0, 0, 0,
-	161, 30, 0, 62, 0, 0, 0, 84, 86, 106,
-	138, 139, 140, 141, 142, 143, 144, 145, 146, 147,
-	148, 149, 150, 151, 152, 153, 154, 155, 156, 157,
-	158, 159, 160, 161, 0, 164, 0, 166, 161, 0,
-	0, 0, 166, 0, 93, 103, 171, 166, 0, 98,
-	99, 0, 101, 102, 70, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 0, 55, 31, 0, 0,
-	0, 0, 0, 20, 0, 22, 0, 0, 0, 0,
-	74, 76, 0, 0, 0, 35, 0, 0, 0, 161,
-	0, 0, 0, 0, 47, 0, 0, 0, -2, 0,
-	173, 55, 97, 0, 0, 72, 75, 0, 0, 77,
-	78, 79, 80, 0, 0, 0, 0, -2, 0, 29,
-	57, -2, 11, 0, 0, -2, 0, -2, 0, 161,
-	34, 61, 73, 0, 123, 124, 0, 32, 161, 95,
-	37, 0, 161, 161, 0, 161, 0, 0, 166, 48,
-	49, 0, 55, 161, 0, 0, 0, -2, 68, 171,
-	0, 71, 0, 118, 119, 0, 0, 0, 0, 88,
-	0, 115, 0, 161, -2, -2, 21, 0, 122, 0,
-	0, 0, 38, 0, 0, 161, 0, 161, 161, 0,
-	0, 50, 51, 161, 56, 0, 0, 161, 161, 0,
-	0, 100, 117, 0, 0, 81, 0, 0, 166, 0,
-	161, 0, 33, 0, 125, 36, 39, 40, 0, 42,
-	0, 0, 161, 46, 54, 161, 161, 63, 0, 0,
-	161, 69, 0, 120, 0, 83, 114, 0, 0, 15,
-	126, 41, 43, 44, 0, 52, 53, 64, 65, 0,
-	121, 82, 171, 116, 14, 0, 45, 66, 0, 0,
-	161, 87, 161, 0, 0, 13, 12,
+	0, -2, 0, 88, 0, 0, -2, 0, 234, 172,
+	234, 146, 0, 0, 0, 172, -2, 90, 235, 180,
+	0, 149, 0, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 127, 0, 0, 136, 0, 0, 234, 87,
+	173, 195, 196, 197, 198, 199, 4, 234, 87, 234,
+	229, 0, 90, 90, 90, 90, 0, 0, 0, 229,
+	0, 87, 105, 144, 229, 0, 91, 93, 40, 0,
+	98, 0, 90, 171, 0, 0, 0, 0, 170, 135,
+	137, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 229, 0, -2, 0, 229, 0,
+	0, 0, 0, 234, 0, -2, 0, 79, 0, 147,
+	146, 0, 118, 0, 146, 167, 239, 93, 0, 239,
+	0, 234, 0, 151, 152, 0, 154, 166, 234, 0,
+	0, 0, 0, 0, 0, 0, 0, 87, 0, 41,
+	0, 13, 0, 0, 0, 0, 0, 0, 0, 26,
+	0, 28, 0, 0, 31, 38, 0, 234, 119, 94,
+	0, 120, 0, 123, 0, 0, 124, 0, 0, 45,
+	0, 0, -2, 0, 0, 0, 0, 60, 0, 234,
+	61, 62, 0, 87, 0, 234, 80, 81, 0, 87,
+	0, 0, 0, 0, 106, 0, 0, 0, -2, 0,
+	241, 146, 0, 0, -2, 183, 90, 150, 0, 0,
+	122, 0, 0, 125, 126, 128, 129, 0, 0, 0,
+	37, 89, 89, 148, 15, 0, 21, 74, -2, -2,
+	-2, -2, 0, 229, 44, 145, 0, 97, 121, 0,
+	191, 192, 0, 134, 42, 229, 47, 0, 229, -2,
+	0, -2, 0, 0, 234, 0, 0, 63, 64, 229,
+	88, 0, 0, 0, 82, 83, 229, 88, 0, 229,
+	0, 0, 0, 0, 107, 0, 0, 0, -2, 112,
+	0, 181, 139, 0, 239, 0, 156, 229, 0, 0,
+	186, 187, 0, 0, 0, 0, 0, 0, 229, 0,
+	0, 75, 0, 27, 0, 92, 190, 0, 0, 0,
+	48, 0, 0, 229, 0, 229, -2, 0, 0, 0,
+	57, 67, 229, 229, 0, 78, 86, 229, 0, 0,
+	229, 0, 229, 0, 108, 0, 0, 0, 146, 0,
+	0, 0, 153, 0, 230, 158, 185, 0, 0, 130,
+	0, 0, 0, 234, 87, 229, 0, 0, 229, 43,
+	0, 193, 46, 49, 50, 0, 52, 0, 0, 229,
+	56, 234, 65, 66, 0, 84, 0, 99, 0, 146,
+	0, 229, 109, 0, 234, 0, 0, 184, 117, 157,
+	0, 159, 0, 188, 0, 132, 133, 180, 39, 0,
+	19, 0, 0, 0, 194, 51, 53, 54, 0, 68,
+	0, 229, 100, 0, 101, 0, 110, 0, 0, 0,
+	182, 160, 189, 131, 239, 0, 18, 0, 0, 229,
+	20, 55, 234, 69, 70, 0, 0, 85, 0, 0,
+	102, 113, 234, 234, 161, 0, 0, 0, 229, 0,
+	0, 0, 71, 72, 0, 162, 163, 234, 229, 0,
+	0, 0, 0, 138, 181, 229, 0, 229, 77, 59,
+	229, 234, 68, 0, 229, 114, 115, 234, 0, 17,
+	0, 73, 0, 234, 103, 0, 0, 16, 76, 164,
+	165, 0, 104, 116, 58,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	81, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	91, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 80, 3, 3, 3, 68, 69, 3,
-	73, 74, 66, 62, 75, 63, 79, 67, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 58, 76,
-	60, 57, 61, 59, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 90, 3, 3, 3, 78, 79, 3,
+	81, 86, 76, 72, 87, 73, 82, 77, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 68, 88,
+	70, 67, 71, 69, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 77, 3, 78, 65, 3, 3, 3, 3, 3,
+	3, 83, 3, 89, 75, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 71, 64, 72,
+	3, 3, 3, 84, 74, 85,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 70,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 80,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -960,9 +1262,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -972,13 +1274,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1009,30 +1311,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -1087,7 +1389,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -1098,8 +1400,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -1112,7 +1414,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -1121,18 +1423,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -1154,10 +1456,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -1193,7 +1495,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -1204,16 +1506,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -1221,19 +1523,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:104
+//line parser.go.y:130
 		{
 			yyVAL.compstmt = nil
 		}
 	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:108
+//line parser.go.y:134
 		{
 			yyVAL.compstmt = yyDollar[1].stmts
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:114
+//line parser.go.y:140
 		{
 			if yyDollar[2].stmt != nil {
 				yyVAL.stmts = &ast.StmtsStmt{Stmts: []ast.Stmt{yyDollar[2].stmt}}
@@ -1244,7 +1546,7 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:123
+//line parser.go.y:149
 		{
 			if yyDollar[3].stmt != nil {
 				if yyDollar[1].stmts == nil {
@@ -1260,186 +1562,294 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:139
+//line parser.go.y:165
 		{
 			yyVAL.stmt = nil
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:143
+//line parser.go.y:169
 		{
 			yyVAL.stmt = yyDollar[1].stmt_var_or_lets
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:147
+//line parser.go.y:173
 		{
 			yyVAL.stmt = &ast.BreakStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:152
+//line parser.go.y:178
 		{
 			yyVAL.stmt = &ast.ContinueStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:157
+//line parser.go.y:183
 		{
-			yyVAL.stmt = &ast.ReturnStmt{Exprs: yyDollar[2].exprs}
+			yyVAL.stmt = &ast.BreakStmt{Label: yyDollar[2].tok.Lit}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.labels = append(l.labels, yyDollar[2].tok)
+			}
 		}
 	case 10:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:162
+//line parser.go.y:191
 		{
-			yyVAL.stmt = &ast.ThrowStmt{Expr: yyDollar[2].expr}
+			yyVAL.stmt = &ast.ContinueStmt{Label: yyDollar[2].tok.Lit}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.labels = append(l.labels, yyDollar[2].tok)
+			}
 		}
 	case 11:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:199
+		{
+			fallthroughStmt := &ast.FallthroughStmt{}
+			fallthroughStmt.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.fallthroughs = append(l.fallthroughs, fallthroughStmt)
+			}
+			yyVAL.stmt = fallthroughStmt
+		}
+	case 12:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:208
+		{
+			yyVAL.stmt = &ast.ReturnStmt{Exprs: yyDollar[2].exprs}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 13:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:213
+		{
+			yyVAL.stmt = &ast.ConstStmt{Names: yyDollar[2].expr_idents, Exprs: yyDollar[4].exprs}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 14:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:218
+		{
+			yyVAL.stmt = &ast.ThrowStmt{Expr: yyDollar[2].expr}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 15:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:167
+//line parser.go.y:223
 		{
 			yyVAL.stmt = &ast.ModuleStmt{Name: yyDollar[2].tok.Lit, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 12:
+	case 16:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser.go.y:172
+//line parser.go.y:228
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt, Finally: yyDollar[12].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 13:
+	case 17:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser.go.y:177
+//line parser.go.y:233
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt, Finally: yyDollar[11].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 14:
+	case 18:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:182
+//line parser.go.y:238
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 15:
+	case 19:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:187
+//line parser.go.y:243
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 16:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:192
+	case 20:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:248
 		{
-			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
-			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
+			tryStmt := yyDollar[5].stmt_try_catches.(*ast.TryStmt)
+			tryStmt.Try = yyDollar[3].compstmt
+			tryStmt.Finally = yyDollar[8].compstmt
+			yyVAL.stmt = tryStmt
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 17:
+	case 21:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:197
+//line parser.go.y:256
+		{
+			tryStmt := yyDollar[5].stmt_try_catches.(*ast.TryStmt)
+			tryStmt.Try = yyDollar[3].compstmt
+			yyVAL.stmt = tryStmt
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 22:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:263
 		{
-			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, Go: true}}
+			subExprs, varArg := callArgs(yyDollar[4].exprs)
+			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: subExprs, VarArg: varArg, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 18:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:202
+	case 23:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:269
 		{
-			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
-			yyVAL.stmt.SetPosition(yyDollar[2].expr.Position())
+			subExprs, varArg := callArgs(yyDollar[4].exprs)
+			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: subExprs, VarArg: varArg, Go: true}}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 19:
+	case 24:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:207
+//line parser.go.y:275
 		{
-			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, Go: true}}
+			subExprs, varArg := callArgs(yyDollar[4].exprs)
+			callExpr := &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: subExprs, VarArg: varArg, Defer: true}
+			callExpr.SetPosition(yyDollar[2].tok.Position())
+			yyVAL.stmt = &ast.DeferStmt{Expr: callExpr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 20:
+	case 25:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:283
+		{
+			subExprs, varArg := callArgs(yyDollar[4].exprs)
+			anonCallExpr := &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: subExprs, VarArg: varArg, Defer: true}
+			anonCallExpr.SetPosition(yyDollar[2].expr.Position())
+			yyVAL.stmt = &ast.DeferStmt{Expr: anonCallExpr}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 26:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:212
+//line parser.go.y:291
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 21:
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:217
+//line parser.go.y:296
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr, Key: yyDollar[5].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 22:
+	case 28:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:222
+//line parser.go.y:301
 		{
 			yyVAL.stmt = &ast.CloseStmt{Expr: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 23:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:227
+//line parser.go.y:306
 		{
 			yyVAL.stmt = yyDollar[1].stmt_if
 		}
-	case 24:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:231
+//line parser.go.y:310
 		{
 			yyVAL.stmt = yyDollar[1].stmt_for
 		}
-	case 25:
+	case 31:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:314
+		{
+			switch stmt := yyDollar[4].stmt_for.(type) {
+			case *ast.LoopStmt:
+				stmt.Label = yyDollar[1].tok.Lit
+			case *ast.ForStmt:
+				stmt.Label = yyDollar[1].tok.Lit
+			case *ast.CForStmt:
+				stmt.Label = yyDollar[1].tok.Lit
+			}
+			if l, ok := yylex.(*Lexer); ok {
+				l.matchLabel(yyDollar[1].tok)
+			}
+			yyVAL.stmt = yyDollar[4].stmt_for
+		}
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:235
+//line parser.go.y:329
 		{
 			yyVAL.stmt = yyDollar[1].stmt_switch
 		}
-	case 26:
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:333
+		{
+			yyVAL.stmt = yyDollar[1].stmt_select
+		}
+	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:239
+//line parser.go.y:337
 		{
 			yyVAL.stmt = &ast.ExprStmt{Expr: yyDollar[1].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 27:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:246
+//line parser.go.y:344
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_var
 		}
-	case 28:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:250
+//line parser.go.y:348
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_lets
 		}
-	case 29:
+	case 37:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:256
+//line parser.go.y:354
 		{
 			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_idents, Exprs: yyDollar[4].exprs}
 			yyVAL.stmt_var.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 30:
+	case 38:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:359
+		{
+			identExpr := &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
+			identExpr.SetPosition(yyDollar[1].tok.Position())
+			yyVAL.stmt_var = newShortVarStmt(yylex, []ast.Expr{identExpr}, yyDollar[4].exprs)
+		}
+	case 39:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:365
+		{
+			if len(yyDollar[1].exprs) == 0 {
+				yylex.Error("syntax error: unexpected ','")
+			}
+			yyVAL.stmt_var = newShortVarStmt(yylex, append(yyDollar[1].exprs, yyDollar[4].expr), yyDollar[7].exprs)
+		}
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:263
+//line parser.go.y:374
 		{
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{yyDollar[3].expr}}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 31:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:268
+//line parser.go.y:379
 		{
 			if len(yyDollar[1].exprs) == 2 && len(yyDollar[3].exprs) == 1 {
 				if _, ok := yyDollar[3].exprs[0].(*ast.ItemExpr); ok {
 					yyVAL.stmt_lets = &ast.LetMapItemStmt{LHSS: yyDollar[1].exprs, RHS: yyDollar[3].exprs[0]}
+				} else if typeAssertExpr, ok := yyDollar[3].exprs[0].(*ast.TypeAssertExpr); ok {
+					typeAssertExpr.Ok = true
+					yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyDollar[1].exprs, RHSS: yyDollar[3].exprs}
 				} else {
 					yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyDollar[1].exprs, RHSS: yyDollar[3].exprs}
 				}
@@ -1447,23 +1857,23 @@ yydefault:
 				yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyDollar[1].exprs, RHSS: yyDollar[3].exprs}
 			}
 		}
-	case 32:
+	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:282
+//line parser.go.y:396
 		{
 			yyVAL.stmt_if = &ast.IfStmt{If: yyDollar[2].expr, Then: yyDollar[4].compstmt, Else: nil}
 			yyVAL.stmt_if.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 33:
+	case 43:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:287
+//line parser.go.y:401
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			ifStmt.ElseIf = append(ifStmt.ElseIf, &ast.IfStmt{If: yyDollar[4].expr, Then: yyDollar[6].compstmt})
 		}
-	case 34:
+	case 44:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:292
+//line parser.go.y:406
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			if ifStmt.Else != nil {
@@ -1471,16 +1881,16 @@ yydefault:
 			}
 			ifStmt.Else = yyDollar[4].compstmt
 		}
-	case 35:
+	case 45:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:302
+//line parser.go.y:416
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Stmt: yyDollar[3].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 36:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:307
+//line parser.go.y:421
 		{
 			if len(yyDollar[2].expr_idents) < 1 {
 				yylex.Error("missing identifier")
@@ -1491,107 +1901,139 @@ yydefault:
 				yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 			}
 		}
-	case 37:
+	case 47:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:318
+//line parser.go.y:432
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Expr: yyDollar[2].expr, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 38:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:323
+//line parser.go.y:437
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt: yyDollar[5].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 39:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:328
+//line parser.go.y:442
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr3: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 40:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:333
+//line parser.go.y:447
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 41:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:338
+//line parser.go.y:452
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 42:
+	case 52:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:343
+//line parser.go.y:457
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 43:
+	case 53:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:348
+//line parser.go.y:462
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 44:
+	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:353
+//line parser.go.y:467
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 45:
+	case 55:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:358
+//line parser.go.y:472
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Expr3: yyDollar[6].expr, Stmt: yyDollar[8].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 46:
+	case 56:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:365
+//line parser.go.y:479
 		{
 			switchStmt := yyDollar[5].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Expr = yyDollar[2].expr
+			if l, ok := yylex.(*Lexer); ok {
+				l.matchFallthroughs(switchStmt)
+			}
 			yyVAL.stmt_switch = switchStmt
 			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 47:
+	case 57:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:489
+		{
+			if l, ok := yylex.(*Lexer); ok {
+				l.matchFallthroughs(yyDollar[4].stmt_switch_cases.(*ast.SwitchStmt))
+			}
+			yyVAL.stmt_switch = yyDollar[4].stmt_switch_cases
+			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 58:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.go.y:497
+		{
+			typeSwitchStmt := yyDollar[12].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			typeSwitchStmt.Var = yyDollar[2].tok.Lit
+			typeSwitchStmt.Expr = yyDollar[5].expr
+			yyVAL.stmt_switch = typeSwitchStmt
+			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 59:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.go.y:505
+		{
+			typeSwitchStmt := yyDollar[9].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			typeSwitchStmt.Expr = yyDollar[2].expr
+			yyVAL.stmt_switch = typeSwitchStmt
+			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:374
+//line parser.go.y:514
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{}
 		}
-	case 48:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:378
+//line parser.go.y:518
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Default: yyDollar[1].stmt_switch_default}
 		}
-	case 49:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:382
+//line parser.go.y:522
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_switch_case}}
 		}
-	case 50:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:386
+//line parser.go.y:526
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Cases = append(switchStmt.Cases, yyDollar[2].stmt_switch_case)
 			yyVAL.stmt_switch_cases = switchStmt
 		}
-	case 51:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:392
+//line parser.go.y:532
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			if switchStmt.Default != nil {
@@ -1599,205 +2041,510 @@ yydefault:
 			}
 			switchStmt.Default = yyDollar[2].stmt_switch_default
 		}
-	case 52:
+	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:402
+//line parser.go.y:542
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: []ast.Expr{yyDollar[2].expr}, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 53:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:407
+//line parser.go.y:547
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: yyDollar[2].exprs, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 54:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:414
+//line parser.go.y:554
 		{
 			yyVAL.stmt_switch_default = yyDollar[3].compstmt
 		}
-	case 55:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:421
+//line parser.go.y:560
 		{
-			yyVAL.exprs = nil
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{}
 		}
-	case 56:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:425
+//line parser.go.y:564
 		{
-			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{Default: yyDollar[1].stmt_switch_default}
 		}
-	case 57:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:429
+	case 70:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:568
 		{
-			if len(yyDollar[1].exprs) == 0 {
-				yylex.Error("syntax error: unexpected ','")
-			}
-			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_type_switch_case}}
 		}
-	case 58:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:436
+	case 71:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:572
 		{
-			if len(yyDollar[1].exprs) == 0 {
-				yylex.Error("syntax error: unexpected ','")
+			typeSwitchStmt := yyDollar[1].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			typeSwitchStmt.Cases = append(typeSwitchStmt.Cases, yyDollar[2].stmt_type_switch_case)
+			yyVAL.stmt_type_switch_cases = typeSwitchStmt
+		}
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:578
+		{
+			typeSwitchStmt := yyDollar[1].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			if typeSwitchStmt.Default != nil {
+				yylex.Error("multiple default statement")
 			}
-			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr_ident)
+			typeSwitchStmt.Default = yyDollar[2].stmt_switch_default
 		}
-	case 59:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:445
+	case 73:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:588
 		{
-			yyVAL.expr = yyDollar[1].expr_member_or_ident
+			yyVAL.stmt_type_switch_case = &ast.TypeSwitchCaseStmt{Types: yyDollar[2].type_data_list, Stmt: yyDollar[4].compstmt}
+			yyVAL.stmt_type_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 60:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:449
+//line parser.go.y:595
 		{
-			yyVAL.expr = yyDollar[1].expr_literals
+			yyVAL.stmt_try_catches = &ast.TryStmt{Catches: []ast.Stmt{yyDollar[1].stmt_try_catch}}
 		}
-	case 61:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:453
+	case 75:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:599
 		{
-			yyVAL.expr = &ast.TernaryOpExpr{Expr: yyDollar[1].expr, LHS: yyDollar[3].expr, RHS: yyDollar[5].expr}
-			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+			tryStmt := yyDollar[1].stmt_try_catches.(*ast.TryStmt)
+			tryStmt.Catches = append(tryStmt.Catches, yyDollar[2].stmt_try_catch)
+			yyVAL.stmt_try_catches = tryStmt
 		}
-	case 62:
+	case 76:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:607
+		{
+			yyVAL.stmt_try_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, Type: yyDollar[5].type_data, Stmt: yyDollar[8].compstmt}
+			yyVAL.stmt_try_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 77:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:612
+		{
+			yyVAL.stmt_try_catch = &ast.CatchStmt{Var: yyDollar[3].tok.Lit, Stmt: yyDollar[6].compstmt}
+			yyVAL.stmt_try_catch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 78:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:619
+		{
+			yyVAL.stmt_select = yyDollar[4].stmt_select_cases
+			yyVAL.stmt_select.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 79:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:626
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{}
+		}
+	case 80:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:630
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{Default: yyDollar[1].stmt_select_default}
+		}
+	case 81:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:634
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{Cases: []ast.Stmt{yyDollar[1].stmt_select_case}}
+		}
+	case 82:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:638
+		{
+			selectStmt := yyDollar[1].stmt_select_cases.(*ast.SelectStmt)
+			selectStmt.Cases = append(selectStmt.Cases, yyDollar[2].stmt_select_case)
+			yyVAL.stmt_select_cases = selectStmt
+		}
+	case 83:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:644
+		{
+			selectStmt := yyDollar[1].stmt_select_cases.(*ast.SelectStmt)
+			if selectStmt.Default != nil {
+				yylex.Error("multiple default statement")
+			}
+			selectStmt.Default = yyDollar[2].stmt_select_default
+		}
+	case 84:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:654
+		{
+			if _, ok := yyDollar[2].expr.(*ast.ChanExpr); !ok {
+				yylex.Error("select case must be receive or send")
+			}
+			yyVAL.stmt_select_case = &ast.SelectCaseStmt{Expr: yyDollar[2].expr, Stmt: yyDollar[4].compstmt}
+			yyVAL.stmt_select_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 85:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:662
+		{
+			if chanExpr, ok := yyDollar[4].expr.(*ast.ChanExpr); !ok || chanExpr.LHS != nil {
+				yylex.Error("select case must be receive")
+			} else if len(yyDollar[2].exprs) < 1 || len(yyDollar[2].exprs) > 2 {
+				yylex.Error("select case must assign one or two values")
+			}
+			yyVAL.stmt_select_case = &ast.SelectCaseStmt{LHSS: yyDollar[2].exprs, Expr: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
+			yyVAL.stmt_select_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 86:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:674
+		{
+			if yyDollar[3].compstmt == nil {
+				// an empty default still needs to be run so select does not block
+				yyVAL.stmt_select_default = &ast.StmtsStmt{}
+			} else {
+				yyVAL.stmt_select_default = yyDollar[3].compstmt
+			}
+		}
+	case 87:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:686
+		{
+			yyVAL.exprs = nil
+		}
+	case 88:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:690
+		{
+			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
+		}
+	case 89:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:694
+		{
+			if len(yyDollar[1].exprs) == 0 {
+				yylex.Error("syntax error: unexpected ','")
+			}
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
+		}
+	case 90:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:703
+		{
+			yyVAL.exprs = nil
+		}
+	case 91:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:707
+		{
+			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
+		}
+	case 92:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:711
+		{
+			if len(yyDollar[1].exprs) == 0 {
+				yylex.Error("syntax error: unexpected ','")
+			}
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:720
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 94:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:724
+		{
+			yyVAL.expr = &ast.SpreadExpr{Expr: yyDollar[1].expr}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 95:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:731
+		{
+			yyVAL.expr = yyDollar[1].expr_member_or_ident
+		}
+	case 96:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:735
+		{
+			yyVAL.expr = yyDollar[1].expr_literals
+		}
+	case 97:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:739
+		{
+			yyVAL.expr = &ast.TernaryOpExpr{Expr: yyDollar[1].expr, LHS: yyDollar[3].expr, RHS: yyDollar[5].expr}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:458
+//line parser.go.y:744
 		{
 			yyVAL.expr = &ast.NilCoalescingOpExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 63:
+	case 99:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:463
+//line parser.go.y:749
 		{
 			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[6].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
 		}
-	case 64:
+	case 100:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:468
+//line parser.go.y:755
 		{
 			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[7].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
 		}
-	case 65:
+	case 101:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:473
+//line parser.go.y:761
 		{
 			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[7].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
 		}
-	case 66:
+	case 102:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:478
+//line parser.go.y:767
 		{
 			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[8].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
 		}
-	case 67:
+	case 103:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.go.y:773
+		{
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[6].tok.Lit, Params: append([]string{yyDollar[3].tok.Lit}, yyDollar[8].expr_idents...), Stmt: yyDollar[11].compstmt, Receiver: yyDollar[4].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 104:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.go.y:779
+		{
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[6].tok.Lit, Params: append([]string{yyDollar[3].tok.Lit}, yyDollar[8].expr_idents...), Stmt: yyDollar[12].compstmt, VarArg: true, Receiver: yyDollar[4].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:785
+		{
+			yyVAL.expr = &ast.FuncExpr{Params: []string{yyDollar[1].tok.Lit}, Stmt: yyDollar[3].compstmt}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 106:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:791
+		{
+			yyVAL.expr = &ast.FuncExpr{Stmt: yyDollar[4].compstmt}
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr.SetPosition(l.pos)
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 107:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:799
+		{
+			identExpr, ok := yyDollar[2].expr.(*ast.IdentExpr)
+			if !ok {
+				yylex.Error("syntax error: unexpected =>")
+				identExpr = &ast.IdentExpr{}
+			}
+			yyVAL.expr = &ast.FuncExpr{Params: []string{identExpr.Lit}, Stmt: yyDollar[5].compstmt}
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr.SetPosition(l.pos)
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 108:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:812
+		{
+			yyVAL.expr = &ast.FuncExpr{Params: []string{yyDollar[2].tok.Lit}, Stmt: yyDollar[6].compstmt, VarArg: true}
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr.SetPosition(l.pos)
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 109:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:820
+		{
+			yyVAL.expr = &ast.FuncExpr{Params: append([]string{yyDollar[2].tok.Lit}, yyDollar[4].expr_idents...), Stmt: yyDollar[7].compstmt}
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr.SetPosition(l.pos)
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 110:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.go.y:828
+		{
+			yyVAL.expr = &ast.FuncExpr{Params: append([]string{yyDollar[2].tok.Lit}, yyDollar[4].expr_idents...), Stmt: yyDollar[8].compstmt, VarArg: true}
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr.SetPosition(l.pos)
+				l.endFunction(yyDollar[1].tok.Position())
+			}
+		}
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:483
+//line parser.go.y:836
 		{
 			yyVAL.expr = &ast.ArrayExpr{}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 68:
+	case 112:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:488
+//line parser.go.y:841
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[3].exprs}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 69:
+	case 113:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.go.y:846
+		{
+			yyVAL.expr = newComprehensionExpr(yylex, nil, yyDollar[3].expr, yyDollar[5].expr_idents, yyDollar[7].expr, nil)
+		}
+	case 114:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.go.y:850
+		{
+			yyVAL.expr = newComprehensionExpr(yylex, nil, yyDollar[3].expr, yyDollar[5].expr_idents, yyDollar[7].expr, yyDollar[9].expr)
+		}
+	case 115:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.go.y:854
+		{
+			yyVAL.expr = newComprehensionExpr(yylex, yyDollar[3].expr, yyDollar[5].expr, yyDollar[7].expr_idents, yyDollar[9].expr, nil)
+		}
+	case 116:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.go.y:858
+		{
+			yyVAL.expr = newComprehensionExpr(yylex, yyDollar[3].expr, yyDollar[5].expr, yyDollar[7].expr_idents, yyDollar[9].expr, yyDollar[11].expr)
+		}
+	case 117:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:493
+//line parser.go.y:862
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[5].exprs, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 70:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:498
+//line parser.go.y:867
 		{
 			yyVAL.expr = &ast.ParenExpr{SubExpr: yyDollar[2].expr}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 71:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:503
+	case 119:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:872
 		{
-			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true}
+			subExprs, varArg := callArgs(yyDollar[3].exprs)
+			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: subExprs, VarArg: varArg}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 72:
+	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:508
+//line parser.go.y:878
 		{
-			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs}
-			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+			subExprs, varArg := callArgs(yyDollar[3].exprs)
+			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: subExprs, VarArg: varArg, Optional: isOptionalChain(yyDollar[1].expr)}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 73:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:513
+//line parser.go.y:884
 		{
-			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs, VarArg: true}
+			subExprs, varArg := callArgs(yyDollar[4].exprs)
+			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: subExprs, VarArg: varArg, Optional: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 74:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:518
+//line parser.go.y:890
 		{
-			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs}
-			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr_ident, Index: yyDollar[3].expr}
+			yyVAL.expr.SetPosition(yyDollar[1].expr_ident.Position())
 		}
-	case 75:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:523
+//line parser.go.y:895
 		{
-			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr_ident, Index: yyDollar[3].expr}
-			yyVAL.expr.SetPosition(yyDollar[1].expr_ident.Position())
+			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr, Optional: isOptionalChain(yyDollar[1].expr)}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 76:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:528
+//line parser.go.y:900
 		{
-			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr}
+			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr, Optional: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 77:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:533
+//line parser.go.y:905
 		{
 			yyVAL.expr = &ast.LenExpr{Expr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 78:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:538
+//line parser.go.y:910
 		{
 			yyVAL.expr = &ast.ImportExpr{Name: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 79:
+	case 127:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:915
+		{
+			name := &ast.LiteralExpr{Literal: stringToValue(yyDollar[2].tok.Lit)}
+			name.SetPosition(yyDollar[2].tok.Position())
+			yyVAL.expr = &ast.ImportExpr{Name: name}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:543
+//line parser.go.y:922
 		{
 			if yyDollar[3].type_data.Kind == ast.TypeDefault {
 				yyDollar[3].type_data.Kind = ast.TypePtr
@@ -1807,107 +2554,134 @@ yydefault:
 			}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 80:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:553
+//line parser.go.y:932
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 81:
+	case 130:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:558
+//line parser.go.y:937
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 82:
+	case 131:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:563
+//line parser.go.y:942
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr, CapExpr: yyDollar[7].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 83:
+	case 132:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:568
+//line parser.go.y:947
 		{
 			yyVAL.expr = &ast.MakeTypeExpr{Name: yyDollar[4].tok.Lit, Type: yyDollar[6].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 84:
+	case 133:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:952
+		{
+			yyVAL.expr = &ast.MakeTypeExpr{Name: yyDollar[4].tok.Lit, TypeData: yyDollar[6].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 134:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:957
+		{
+			yyVAL.expr = &ast.TypeAssertExpr{Expr: yyDollar[1].expr, TypeData: yyDollar[4].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:573
+//line parser.go.y:962
 		{
 			yyVAL.expr = &ast.ChanExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 85:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:578
+//line parser.go.y:967
 		{
 			yyVAL.expr = &ast.ChanExpr{RHS: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 86:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:583
+//line parser.go.y:972
 		{
 			yyVAL.expr = &ast.IncludeExpr{ItemExpr: yyDollar[1].expr, ListExpr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 87:
+	case 138:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser.go.y:588
+//line parser.go.y:977
 		{
 			yyDollar[8].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 			yyVAL.expr = yyDollar[8].expr_map
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 88:
+	case 139:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:594
+//line parser.go.y:983
 		{
 			yyVAL.expr = yyDollar[3].expr_map
 			yyVAL.expr.SetPosition(yyDollar[3].expr_map.Position())
 		}
-	case 89:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:599
+//line parser.go.y:988
 		{
 			yyVAL.expr = yyDollar[1].expr_slice
 			yyVAL.expr.SetPosition(yyDollar[1].expr_slice.Position())
 		}
-	case 93:
+	case 144:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:998
+		{
+			yyVAL.compstmt = &ast.ReturnStmt{Exprs: []ast.Expr{yyDollar[1].expr}}
+			yyVAL.compstmt.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 145:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1003
+		{
+			yyVAL.compstmt = yyDollar[2].compstmt
+		}
+	case 146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:608
+//line parser.go.y:1008
 		{
 			yyVAL.expr_idents = []string{}
 		}
-	case 94:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:612
+//line parser.go.y:1012
 		{
 			yyVAL.expr_idents = []string{yyDollar[1].tok.Lit}
 		}
-	case 95:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:616
+//line parser.go.y:1016
 		{
 			if len(yyDollar[1].expr_idents) == 0 {
 				yylex.Error("syntax error: unexpected ','")
 			}
 			yyVAL.expr_idents = append(yyDollar[1].expr_idents, yyDollar[4].tok.Lit)
 		}
-	case 96:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:625
+//line parser.go.y:1025
 		{
 			yyVAL.type_data = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
 		}
-	case 97:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:629
+//line parser.go.y:1029
 		{
 			if yyDollar[1].type_data.Kind != ast.TypeDefault {
 				yylex.Error("not type default")
@@ -1916,9 +2690,9 @@ yydefault:
 				yyDollar[1].type_data.Name = yyDollar[3].tok.Lit
 			}
 		}
-	case 98:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:638
+//line parser.go.y:1038
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypePtr
@@ -1927,9 +2701,9 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypePtr, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 99:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:647
+//line parser.go.y:1047
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeSlice
@@ -1939,15 +2713,15 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}
 			}
 		}
-	case 100:
+	case 153:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:657
+//line parser.go.y:1057
 		{
 			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 		}
-	case 101:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:661
+//line parser.go.y:1061
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeChan
@@ -1956,47 +2730,136 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeChan, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 102:
+	case 155:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1070
+		{
+			yyVAL.type_data = yyDollar[1].type_data
+		}
+	case 156:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1076
+		{
+			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeStructType}
+		}
+	case 157:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1080
+		{
+			yyVAL.type_data = yyDollar[4].type_data
+		}
+	case 158:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1086
+		{
+			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{yyDollar[1].tok.Lit}, StructTypes: []*ast.TypeStruct{yyDollar[2].type_data}, StructTags: []string{""}}
+		}
+	case 159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1090
+		{
+			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{yyDollar[1].tok.Lit}, StructTypes: []*ast.TypeStruct{yyDollar[2].type_data}, StructTags: []string{yyDollar[3].tok.Lit}}
+		}
+	case 160:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1094
+		{
+			for _, name := range yyDollar[1].type_data.StructNames {
+				if name == yyDollar[3].tok.Lit {
+					yylex.Error("duplicate struct field " + name)
+					break
+				}
+			}
+			yyDollar[1].type_data.StructNames = append(yyDollar[1].type_data.StructNames, yyDollar[3].tok.Lit)
+			yyDollar[1].type_data.StructTypes = append(yyDollar[1].type_data.StructTypes, yyDollar[4].type_data)
+			yyDollar[1].type_data.StructTags = append(yyDollar[1].type_data.StructTags, "")
+		}
+	case 161:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1106
+		{
+			for _, name := range yyDollar[1].type_data.StructNames {
+				if name == yyDollar[3].tok.Lit {
+					yylex.Error("duplicate struct field " + name)
+					break
+				}
+			}
+			yyDollar[1].type_data.StructNames = append(yyDollar[1].type_data.StructNames, yyDollar[3].tok.Lit)
+			yyDollar[1].type_data.StructTypes = append(yyDollar[1].type_data.StructTypes, yyDollar[4].type_data)
+			yyDollar[1].type_data.StructTags = append(yyDollar[1].type_data.StructTags, yyDollar[5].tok.Lit)
+		}
+	case 162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1121
+		{
+			yyVAL.type_data_list = []*ast.TypeStruct{yyDollar[1].type_data}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1125
+		{
+			yyVAL.type_data_list = []*ast.TypeStruct{nil}
+		}
+	case 164:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1129
+		{
+			yyVAL.type_data_list = append(yyDollar[1].type_data_list, yyDollar[4].type_data)
+		}
+	case 165:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1133
+		{
+			yyVAL.type_data_list = append(yyDollar[1].type_data_list, nil)
+		}
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:673
+//line parser.go.y:1139
 		{
 			yyVAL.slice_count = 1
 		}
-	case 103:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:677
+//line parser.go.y:1143
 		{
 			yyVAL.slice_count = yyDollar[3].slice_count + 1
 		}
-	case 104:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:683
+//line parser.go.y:1149
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_member
 		}
-	case 105:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:687
+//line parser.go.y:1153
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_ident
 		}
-	case 106:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:693
+//line parser.go.y:1159
 		{
-			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit}
+			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit, Optional: isOptionalChain(yyDollar[1].expr)}
 			yyVAL.expr_member.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 107:
+	case 171:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1164
+		{
+			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit, Optional: true}
+			yyVAL.expr_member.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:700
+//line parser.go.y:1171
 		{
 			yyVAL.expr_ident = &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
 			yyVAL.expr_ident.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 108:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:707
+//line parser.go.y:1178
 		{
 			num, err := toNumber("-" + yyDollar[2].tok.Lit)
 			if err != nil {
@@ -2005,9 +2868,9 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 109:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:716
+//line parser.go.y:1187
 		{
 			num, err := toNumber(yyDollar[1].tok.Lit)
 			if err != nil {
@@ -2016,49 +2879,55 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 110:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:725
+//line parser.go.y:1196
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: stringToValue(yyDollar[1].tok.Lit)}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 111:
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1201
+		{
+			yyVAL.expr_literals = yyDollar[1].expr
+		}
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:730
+//line parser.go.y:1205
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: trueValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 112:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:735
+//line parser.go.y:1210
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: falseValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 113:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:740
+//line parser.go.y:1215
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: nilValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 114:
+	case 180:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:747
+//line parser.go.y:1222
 		{
 			yyVAL.expr_map = &ast.MapExpr{}
 		}
-	case 115:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:751
+//line parser.go.y:1226
 		{
 			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{yyDollar[1].expr}, Values: []ast.Expr{yyDollar[3].expr}}
 		}
-	case 116:
+	case 182:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:755
+//line parser.go.y:1230
 		{
 			if yyDollar[1].expr_map.Keys == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2066,132 +2935,152 @@ yydefault:
 			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, yyDollar[4].expr)
 			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, yyDollar[6].expr)
 		}
-	case 117:
+	case 183:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1238
+		{
+			spreadExpr := &ast.SpreadExpr{Expr: yyDollar[2].expr}
+			spreadExpr.SetPosition(yyDollar[1].tok.Position())
+			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{spreadExpr}, Values: []ast.Expr{nil}}
+		}
+	case 184:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1244
+		{
+			if yyDollar[1].expr_map.Keys == nil {
+				yylex.Error("syntax error: unexpected ','")
+			}
+			spreadExpr := &ast.SpreadExpr{Expr: yyDollar[5].expr}
+			spreadExpr.SetPosition(yyDollar[4].tok.Position())
+			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, spreadExpr)
+			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, nil)
+		}
+	case 185:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:765
+//line parser.go.y:1256
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 118:
+	case 186:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:769
+//line parser.go.y:1260
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 119:
+	case 187:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:773
+//line parser.go.y:1264
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 120:
+	case 188:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:777
+//line parser.go.y:1268
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 121:
+	case 189:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:781
+//line parser.go.y:1272
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 122:
+	case 190:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:785
+//line parser.go.y:1276
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 123:
+	case 191:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:789
+//line parser.go.y:1280
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 124:
+	case 192:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:793
+//line parser.go.y:1284
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 125:
+	case 193:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:797
+//line parser.go.y:1288
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 126:
+	case 194:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:801
+//line parser.go.y:1292
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 127:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:807
+//line parser.go.y:1298
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "-", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 128:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:812
+//line parser.go.y:1303
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "!", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 129:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:817
+//line parser.go.y:1308
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "^", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 130:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:822
+//line parser.go.y:1313
 		{
 			yyVAL.expr = &ast.AddrExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 131:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:827
+//line parser.go.y:1318
 		{
 			yyVAL.expr = &ast.DerefExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 132:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:834
+//line parser.go.y:1325
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 133:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:839
+//line parser.go.y:1330
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 134:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:844
+//line parser.go.y:1335
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 135:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:849
+//line parser.go.y:1340
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 136:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:856
+//line parser.go.y:1347
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2199,9 +3088,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 137:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:864
+//line parser.go.y:1355
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2209,9 +3098,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 138:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:872
+//line parser.go.y:1363
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2219,9 +3108,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 139:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:880
+//line parser.go.y:1371
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2229,9 +3118,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 140:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:888
+//line parser.go.y:1379
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2239,9 +3128,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 141:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:896
+//line parser.go.y:1387
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2249,9 +3138,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 142:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:904
+//line parser.go.y:1395
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2259,9 +3148,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 143:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:912
+//line parser.go.y:1403
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2269,121 +3158,121 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 144:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:923
+//line parser.go.y:1414
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 145:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:928
+//line parser.go.y:1419
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 146:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:933
+//line parser.go.y:1424
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "%", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 147:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:938
+//line parser.go.y:1429
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "<<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 148:
+	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:943
+//line parser.go.y:1434
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: ">>", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 149:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:948
+//line parser.go.y:1439
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 150:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:955
+//line parser.go.y:1446
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 151:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:960
+//line parser.go.y:1451
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 152:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:965
+//line parser.go.y:1456
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 153:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:972
+//line parser.go.y:1463
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "==", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 154:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:977
+//line parser.go.y:1468
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "!=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 155:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:982
+//line parser.go.y:1473
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 156:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:987
+//line parser.go.y:1478
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 157:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:992
+//line parser.go.y:1483
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 158:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:997
+//line parser.go.y:1488
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 159:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1004
+//line parser.go.y:1495
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "&&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 160:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1009
+//line parser.go.y:1500
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "||", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
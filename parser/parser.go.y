@@ -19,11 +19,24 @@ import (
 %type<stmt_switch_cases> stmt_switch_cases
 %type<stmt_switch_case> stmt_switch_case
 %type<stmt_switch_default> stmt_switch_default
+%type<stmt_type_switch_cases> stmt_type_switch_cases
+%type<stmt_type_switch_case> stmt_type_switch_case
+%type<stmt_select> stmt_select
+%type<stmt_select_cases> stmt_select_cases
+%type<stmt_select_case> stmt_select_case
+%type<stmt_select_default> stmt_select_default
+%type<stmt_try_catches> stmt_try_catches
+%type<stmt_try_catch> stmt_try_catch
 
 %type<exprs> exprs
+%type<exprs> spread_exprs
+%type<expr> spread_expr
 %type<expr> expr
 %type<expr_idents> expr_idents
 %type<type_data> type_data
+%type<type_data> type_data_struct
+%type<type_data> type_data_struct_fields
+%type<type_data_list> type_data_list
 %type<slice_count> slice_count
 %type<expr_member_or_ident> expr_member_or_ident
 %type<expr_member> expr_member
@@ -34,6 +47,7 @@ import (
 %type<expr> expr_unary
 %type<expr> expr_binary
 %type<expr> expr_lets
+%type<compstmt> arrow_body
 
 %type<expr> op_binary
 %type<expr> op_comparison
@@ -55,11 +69,20 @@ import (
 	stmt_switch_cases      ast.Stmt
 	stmt_switch_case       ast.Stmt
 	stmt_switch_default    ast.Stmt
+	stmt_type_switch_cases ast.Stmt
+	stmt_type_switch_case  ast.Stmt
+	stmt_select            ast.Stmt
+	stmt_select_cases      ast.Stmt
+	stmt_select_case       ast.Stmt
+	stmt_select_default    ast.Stmt
+	stmt_try_catches       ast.Stmt
+	stmt_try_catch         ast.Stmt
 
 	exprs                  []ast.Expr
 	expr                   ast.Expr
 	expr_idents            []string
 	type_data              *ast.TypeStruct
+	type_data_list         []*ast.TypeStruct
 	slice_count            int
 	expr_member_or_ident   ast.Expr
 	expr_member            *ast.MemberExpr
@@ -77,10 +100,12 @@ import (
 	op_multiply            ast.Operator
 }
 
-%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN MAKE OPCHAN TYPE LEN DELETE CLOSE MAP IMPORT
+%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR CONST THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE OPTCHAIN OPTINDEX ARROW ARROWBLOCK MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT FALLTHROUGH GO CHAN MAKE OPCHAN TYPE LEN DELETE CLOSE MAP IMPORT SELECT DEFER STRUCT
+%token<expr> INTERPOLATEDSTRING
 
 /* lowest precedence */
 %left ,
+%right ARROW
 %right '=' PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ
 %right ':'
 %right OPCHAN
@@ -93,6 +118,7 @@ import (
 %right IN
 %right PLUSPLUS MINUSMINUS
 %right UNARY
+%left OPTCHAIN OPTINDEX '(' '.' '['
 /* highest precedence */
 /* https://golang.org/ref/spec#Expression */
 
@@ -153,11 +179,41 @@ stmt :
 		$$ = &ast.ContinueStmt{}
 		$$.SetPosition($1.Position())
 	}
+	| BREAK IDENT
+	{
+		$$ = &ast.BreakStmt{Label: $2.Lit}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok {
+			l.labels = append(l.labels, $2)
+		}
+	}
+	| CONTINUE IDENT
+	{
+		$$ = &ast.ContinueStmt{Label: $2.Lit}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok {
+			l.labels = append(l.labels, $2)
+		}
+	}
+	| FALLTHROUGH
+	{
+		fallthroughStmt := &ast.FallthroughStmt{}
+		fallthroughStmt.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok {
+			l.fallthroughs = append(l.fallthroughs, fallthroughStmt)
+		}
+		$$ = fallthroughStmt
+	}
 	| RETURN exprs
 	{
 		$$ = &ast.ReturnStmt{Exprs: $2}
 		$$.SetPosition($1.Position())
 	}
+	| CONST expr_idents '=' exprs
+	{
+		$$ = &ast.ConstStmt{Names: $2, Exprs: $4}
+		$$.SetPosition($1.Position())
+	}
 	| THROW expr
 	{
 		$$ = &ast.ThrowStmt{Expr: $2}
@@ -188,24 +244,47 @@ stmt :
 		$$ = &ast.TryStmt{Try: $3, Catch: $7}
 		$$.SetPosition($1.Position())
 	}
-	| GO IDENT '(' exprs VARARG ')'
+	| TRY '{' compstmt '}' stmt_try_catches FINALLY '{' compstmt '}'
 	{
-		$$ = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: $2.Lit, SubExprs: $4, VarArg: true, Go: true}}
-		$$.SetPosition($2.Position())
+		tryStmt := $5.(*ast.TryStmt)
+		tryStmt.Try = $3
+		tryStmt.Finally = $8
+		$$ = tryStmt
+		$$.SetPosition($1.Position())
 	}
-	| GO IDENT '(' exprs ')'
+	| TRY '{' compstmt '}' stmt_try_catches
 	{
-		$$ = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: $2.Lit, SubExprs: $4, Go: true}}
-		$$.SetPosition($2.Position())
+		tryStmt := $5.(*ast.TryStmt)
+		tryStmt.Try = $3
+		$$ = tryStmt
+		$$.SetPosition($1.Position())
 	}
-	| GO expr '(' exprs VARARG ')'
+	| GO IDENT '(' spread_exprs ')'
 	{
-		$$ = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: $4, VarArg: true, Go: true}}
+		subExprs, varArg := callArgs($4)
+		$$ = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: $2.Lit, SubExprs: subExprs, VarArg: varArg, Go: true}}
 		$$.SetPosition($2.Position())
 	}
-	| GO expr '(' exprs ')'
+	| GO expr '(' spread_exprs ')'
+	{
+		subExprs, varArg := callArgs($4)
+		$$ = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: subExprs, VarArg: varArg, Go: true}}
+		$$.SetPosition($1.Position())
+	}
+	| DEFER IDENT '(' spread_exprs ')'
 	{
-		$$ = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: $4, Go: true}}
+		subExprs, varArg := callArgs($4)
+		callExpr := &ast.CallExpr{Name: $2.Lit, SubExprs: subExprs, VarArg: varArg, Defer: true}
+		callExpr.SetPosition($2.Position())
+		$$ = &ast.DeferStmt{Expr: callExpr}
+		$$.SetPosition($1.Position())
+	}
+	| DEFER expr '(' spread_exprs ')'
+	{
+		subExprs, varArg := callArgs($4)
+		anonCallExpr := &ast.AnonCallExpr{Expr: $2, SubExprs: subExprs, VarArg: varArg, Defer: true}
+		anonCallExpr.SetPosition($2.Position())
+		$$ = &ast.DeferStmt{Expr: anonCallExpr}
 		$$.SetPosition($1.Position())
 	}
 	| DELETE '(' expr ')'
@@ -231,10 +310,29 @@ stmt :
 	{
 		$$ = $1
 	}
+	| IDENT ':' opt_newlines stmt_for
+	{
+		switch stmt := $4.(type) {
+		case *ast.LoopStmt:
+			stmt.Label = $1.Lit
+		case *ast.ForStmt:
+			stmt.Label = $1.Lit
+		case *ast.CForStmt:
+			stmt.Label = $1.Lit
+		}
+		if l, ok := yylex.(*Lexer); ok {
+			l.matchLabel($1)
+		}
+		$$ = $4
+	}
 	| stmt_switch
 	{
 		$$ = $1
 	}
+	| stmt_select
+	{
+		$$ = $1
+	}
 	| expr
 	{
 		$$ = &ast.ExprStmt{Expr: $1}
@@ -257,6 +355,19 @@ stmt_var :
 		$$ = &ast.VarStmt{Names: $2, Exprs: $4}
 		$$.SetPosition($1.Position())
 	}
+	| IDENT ':' '=' exprs
+	{
+		identExpr := &ast.IdentExpr{Lit: $1.Lit}
+		identExpr.SetPosition($1.Position())
+		$$ = newShortVarStmt(yylex, []ast.Expr{identExpr}, $4)
+	}
+	| exprs ',' opt_newlines expr ':' '=' exprs
+	{
+		if len($1) == 0 {
+			yylex.Error("syntax error: unexpected ','")
+		}
+		$$ = newShortVarStmt(yylex, append($1, $4), $7)
+	}
 
 stmt_lets :
 	expr '=' expr
@@ -269,6 +380,9 @@ stmt_lets :
 		if len($1) == 2 && len($3) == 1 {
 			if _, ok := $3[0].(*ast.ItemExpr); ok {
 				$$ = &ast.LetMapItemStmt{LHSS: $1, RHS: $3[0]}
+			} else if typeAssertExpr, ok := $3[0].(*ast.TypeAssertExpr); ok {
+				typeAssertExpr.Ok = true
+				$$ = &ast.LetsStmt{LHSS: $1, RHSS: $3}
 			} else {
 				$$ = &ast.LetsStmt{LHSS: $1, RHSS: $3}
 			}
@@ -365,9 +479,35 @@ stmt_switch :
 	{
 		switchStmt := $5.(*ast.SwitchStmt)
 		switchStmt.Expr = $2
+		if l, ok := yylex.(*Lexer); ok {
+			l.matchFallthroughs(switchStmt)
+		}
 		$$ = switchStmt
 		$$.SetPosition($1.Position())
 	}
+	| SWITCH '{' opt_newlines stmt_switch_cases opt_newlines '}'
+	{
+		if l, ok := yylex.(*Lexer); ok {
+			l.matchFallthroughs($4.(*ast.SwitchStmt))
+		}
+		$$ = $4
+		$$.SetPosition($1.Position())
+	}
+	| SWITCH IDENT ':' '=' expr '.' '(' TYPE ')' '{' opt_newlines stmt_type_switch_cases opt_newlines '}'
+	{
+		typeSwitchStmt := $12.(*ast.TypeSwitchStmt)
+		typeSwitchStmt.Var = $2.Lit
+		typeSwitchStmt.Expr = $5
+		$$ = typeSwitchStmt
+		$$.SetPosition($1.Position())
+	}
+	| SWITCH expr '.' '(' TYPE ')' '{' opt_newlines stmt_type_switch_cases opt_newlines '}'
+	{
+		typeSwitchStmt := $9.(*ast.TypeSwitchStmt)
+		typeSwitchStmt.Expr = $2
+		$$ = typeSwitchStmt
+		$$.SetPosition($1.Position())
+	}
 
 stmt_switch_cases :
 	/* nothing */
@@ -415,6 +555,131 @@ stmt_switch_default :
 		$$ = $3
 	}
 
+stmt_type_switch_cases :
+	/* nothing */
+	{
+		$$ = &ast.TypeSwitchStmt{}
+	}
+	| stmt_switch_default
+	{
+		$$ = &ast.TypeSwitchStmt{Default: $1}
+	}
+	| stmt_type_switch_case
+	{
+		$$ = &ast.TypeSwitchStmt{Cases: []ast.Stmt{$1}}
+	}
+	| stmt_type_switch_cases stmt_type_switch_case
+	{
+		typeSwitchStmt := $1.(*ast.TypeSwitchStmt)
+		typeSwitchStmt.Cases = append(typeSwitchStmt.Cases, $2)
+		$$ = typeSwitchStmt
+	}
+	| stmt_type_switch_cases stmt_switch_default
+	{
+		typeSwitchStmt := $1.(*ast.TypeSwitchStmt)
+		if typeSwitchStmt.Default != nil {
+			yylex.Error("multiple default statement")
+		}
+		typeSwitchStmt.Default = $2
+	}
+
+stmt_type_switch_case :
+	CASE type_data_list ':' compstmt
+	{
+		$$ = &ast.TypeSwitchCaseStmt{Types: $2, Stmt: $4}
+		$$.SetPosition($1.Position())
+	}
+
+stmt_try_catches :
+	stmt_try_catch
+	{
+		$$ = &ast.TryStmt{Catches: []ast.Stmt{$1}}
+	}
+	| stmt_try_catches stmt_try_catch
+	{
+		tryStmt := $1.(*ast.TryStmt)
+		tryStmt.Catches = append(tryStmt.Catches, $2)
+		$$ = tryStmt
+	}
+
+stmt_try_catch :
+	CATCH '(' IDENT ':' type_data ')' '{' compstmt '}'
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, Type: $5, Stmt: $8}
+		$$.SetPosition($1.Position())
+	}
+	| CATCH '(' IDENT ')' '{' compstmt '}'
+	{
+		$$ = &ast.CatchStmt{Var: $3.Lit, Stmt: $6}
+		$$.SetPosition($1.Position())
+	}
+
+stmt_select :
+	SELECT '{' opt_newlines stmt_select_cases opt_newlines '}'
+	{
+		$$ = $4
+		$$.SetPosition($1.Position())
+	}
+
+stmt_select_cases :
+	/* nothing */
+	{
+		$$ = &ast.SelectStmt{}
+	}
+	| stmt_select_default
+	{
+		$$ = &ast.SelectStmt{Default: $1}
+	}
+	| stmt_select_case
+	{
+		$$ = &ast.SelectStmt{Cases: []ast.Stmt{$1}}
+	}
+	| stmt_select_cases stmt_select_case
+	{
+		selectStmt := $1.(*ast.SelectStmt)
+		selectStmt.Cases = append(selectStmt.Cases, $2)
+		$$ = selectStmt
+	}
+	| stmt_select_cases stmt_select_default
+	{
+		selectStmt := $1.(*ast.SelectStmt)
+		if selectStmt.Default != nil {
+			yylex.Error("multiple default statement")
+		}
+		selectStmt.Default = $2
+	}
+
+stmt_select_case :
+	CASE expr ':' compstmt
+	{
+		if _, ok := $2.(*ast.ChanExpr); !ok {
+			yylex.Error("select case must be receive or send")
+		}
+		$$ = &ast.SelectCaseStmt{Expr: $2, Stmt: $4}
+		$$.SetPosition($1.Position())
+	}
+	| CASE exprs '=' expr ':' compstmt
+	{
+		if chanExpr, ok := $4.(*ast.ChanExpr); !ok || chanExpr.LHS != nil {
+			yylex.Error("select case must be receive")
+		} else if len($2) < 1 || len($2) > 2 {
+			yylex.Error("select case must assign one or two values")
+		}
+		$$ = &ast.SelectCaseStmt{LHSS: $2, Expr: $4, Stmt: $6}
+		$$.SetPosition($1.Position())
+	}
+
+stmt_select_default :
+	DEFAULT ':' compstmt
+	{
+		if $3 == nil {
+			// an empty default still needs to be run so select does not block
+			$$ = &ast.StmtsStmt{}
+		} else {
+			$$ = $3
+		}
+	}
+
 
 exprs :
 	/* nothing */
@@ -432,7 +697,17 @@ exprs :
 		}
 		$$ = append($1, $4)
 	}
-	| exprs ',' opt_newlines expr_ident
+
+spread_exprs :
+	/* nothing */
+	{
+		$$ = nil
+	}
+	| spread_expr
+	{
+		$$ = []ast.Expr{$1}
+	}
+	| spread_exprs ',' opt_newlines spread_expr
 	{
 		if len($1) == 0 {
 			yylex.Error("syntax error: unexpected ','")
@@ -440,6 +715,17 @@ exprs :
 		$$ = append($1, $4)
 	}
 
+spread_expr :
+	expr
+	{
+		$$ = $1
+	}
+	| expr VARARG
+	{
+		$$ = &ast.SpreadExpr{Expr: $1}
+		$$.SetPosition($1.Position())
+	}
+
 expr :
 	expr_member_or_ident
 	{
@@ -463,33 +749,116 @@ expr :
 	{
 		$$ = &ast.FuncExpr{Params: $3, Stmt: $6}
 		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
 	}
 	| FUNC '(' expr_idents VARARG ')' '{' compstmt '}'
 	{
 		$$ = &ast.FuncExpr{Params: $3, Stmt: $7, VarArg: true}
 		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
 	}
 	| FUNC IDENT '(' expr_idents ')' '{' compstmt '}'
 	{
 		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $7}
 		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
 	}
 	| FUNC IDENT '(' expr_idents VARARG ')' '{' compstmt '}'
 	{
 		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $8, VarArg: true}
 		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
+	}
+	| FUNC '(' IDENT type_data ')' IDENT '(' expr_idents ')' '{' compstmt '}'
+	{
+		$$ = &ast.FuncExpr{Name: $6.Lit, Params: append([]string{$3.Lit}, $8...), Stmt: $11, Receiver: $4}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
+	}
+	| FUNC '(' IDENT type_data ')' IDENT '(' expr_idents VARARG ')' '{' compstmt '}'
+	{
+		$$ = &ast.FuncExpr{Name: $6.Lit, Params: append([]string{$3.Lit}, $8...), Stmt: $12, VarArg: true, Receiver: $4}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
+	}
+	| IDENT ARROW arrow_body
+	{
+		$$ = &ast.FuncExpr{Params: []string{$1.Lit}, Stmt: $3}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.endFunction($1.Position()) }
+	}
+	| '(' ')' ARROW arrow_body
+	{
+		$$ = &ast.FuncExpr{Stmt: $4}
+		if l, ok := yylex.(*Lexer); ok {
+			$$.SetPosition(l.pos)
+			l.endFunction($<tok>1.Position())
+		}
+	}
+	| '(' expr ')' ARROW arrow_body
+	{
+		identExpr, ok := $2.(*ast.IdentExpr)
+		if !ok {
+			yylex.Error("syntax error: unexpected =>")
+			identExpr = &ast.IdentExpr{}
+		}
+		$$ = &ast.FuncExpr{Params: []string{identExpr.Lit}, Stmt: $5}
+		if l, ok := yylex.(*Lexer); ok {
+			$$.SetPosition(l.pos)
+			l.endFunction($<tok>1.Position())
+		}
+	}
+	| '(' IDENT VARARG ')' ARROW arrow_body
+	{
+		$$ = &ast.FuncExpr{Params: []string{$2.Lit}, Stmt: $6, VarArg: true}
+		if l, ok := yylex.(*Lexer); ok {
+			$$.SetPosition(l.pos)
+			l.endFunction($<tok>1.Position())
+		}
+	}
+	| '(' IDENT ',' expr_idents ')' ARROW arrow_body
+	{
+		$$ = &ast.FuncExpr{Params: append([]string{$2.Lit}, $4...), Stmt: $7}
+		if l, ok := yylex.(*Lexer); ok {
+			$$.SetPosition(l.pos)
+			l.endFunction($<tok>1.Position())
+		}
+	}
+	| '(' IDENT ',' expr_idents VARARG ')' ARROW arrow_body
+	{
+		$$ = &ast.FuncExpr{Params: append([]string{$2.Lit}, $4...), Stmt: $8, VarArg: true}
+		if l, ok := yylex.(*Lexer); ok {
+			$$.SetPosition(l.pos)
+			l.endFunction($<tok>1.Position())
+		}
 	}
 	| '[' ']'
 	{
 		$$ = &ast.ArrayExpr{}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
-	| '[' opt_newlines exprs opt_comma_newlines ']'
+	| '[' opt_newlines spread_exprs opt_comma_newlines ']'
 	{
 		$$ = &ast.ArrayExpr{Exprs: $3}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
-	| slice_count type_data '{' opt_newlines exprs opt_comma_newlines '}'
+	| '[' opt_newlines expr FOR expr_idents IN expr opt_newlines ']'
+	{
+		$$ = newComprehensionExpr(yylex, nil, $3, $5, $7, nil)
+	}
+	| '[' opt_newlines expr FOR expr_idents IN expr IF expr opt_newlines ']'
+	{
+		$$ = newComprehensionExpr(yylex, nil, $3, $5, $7, $9)
+	}
+	| '{' opt_newlines expr ':' expr FOR expr_idents IN expr opt_newlines '}'
+	{
+		$$ = newComprehensionExpr(yylex, $3, $5, $7, $9, nil)
+	}
+	| '{' opt_newlines expr ':' expr FOR expr_idents IN expr IF expr opt_newlines '}'
+	{
+		$$ = newComprehensionExpr(yylex, $3, $5, $7, $9, $11)
+	}
+	| slice_count type_data '{' opt_newlines spread_exprs opt_comma_newlines '}'
 	{
 		$$ = &ast.ArrayExpr{Exprs: $5, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: $2, Dimensions: $1}}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
@@ -499,24 +868,22 @@ expr :
 		$$ = &ast.ParenExpr{SubExpr: $2}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
-	| IDENT '(' exprs VARARG ')'
+	| IDENT '(' spread_exprs ')'
 	{
-		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: $3, VarArg: true}
+		subExprs, varArg := callArgs($3)
+		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: subExprs, VarArg: varArg}
 		$$.SetPosition($1.Position())
 	}
-	| IDENT '(' exprs ')'
+	| expr '(' spread_exprs ')'
 	{
-		$$ = &ast.CallExpr{Name: $1.Lit, SubExprs: $3}
+		subExprs, varArg := callArgs($3)
+		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: subExprs, VarArg: varArg, Optional: isOptionalChain($1)}
 		$$.SetPosition($1.Position())
 	}
-	| expr '(' exprs VARARG ')'
+	| expr OPTCHAIN '(' spread_exprs ')'
 	{
-		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: $3, VarArg: true}
-		$$.SetPosition($1.Position())
-	}
-	| expr '(' exprs ')'
-	{
-		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: $3}
+		subExprs, varArg := callArgs($4)
+		$$ = &ast.AnonCallExpr{Expr: $1, SubExprs: subExprs, VarArg: varArg, Optional: true}
 		$$.SetPosition($1.Position())
 	}
 	| expr_ident '[' expr ']'
@@ -526,7 +893,12 @@ expr :
 	}
 	| expr '[' expr ']'
 	{
-		$$ = &ast.ItemExpr{Item: $1, Index: $3}
+		$$ = &ast.ItemExpr{Item: $1, Index: $3, Optional: isOptionalChain($1)}
+		$$.SetPosition($1.Position())
+	}
+	| expr OPTINDEX expr ']'
+	{
+		$$ = &ast.ItemExpr{Item: $1, Index: $3, Optional: true}
 		$$.SetPosition($1.Position())
 	}
 	| LEN '(' expr ')'
@@ -539,6 +911,13 @@ expr :
 		$$ = &ast.ImportExpr{Name: $3}
 		$$.SetPosition($1.Position())
 	}
+	| IMPORT STRING
+	{
+		name := &ast.LiteralExpr{Literal: stringToValue($2.Lit)}
+		name.SetPosition($2.Position())
+		$$ = &ast.ImportExpr{Name: name}
+		$$.SetPosition($1.Position())
+	}
 	| NEW '(' type_data ')'
 	{
 		if $3.Kind == ast.TypeDefault {
@@ -569,6 +948,16 @@ expr :
 		$$ = &ast.MakeTypeExpr{Name: $4.Lit, Type: $6}
 		$$.SetPosition($1.Position())
 	}
+	| MAKE '(' TYPE IDENT ',' type_data_struct ')'
+	{
+		$$ = &ast.MakeTypeExpr{Name: $4.Lit, TypeData: $6}
+		$$.SetPosition($1.Position())
+	}
+	| expr '.' '(' type_data ')'
+	{
+		$$ = &ast.TypeAssertExpr{Expr: $1, TypeData: $4}
+		$$.SetPosition($1.Position())
+	}
 	| expr OPCHAN expr
 	{
 		$$ = &ast.ChanExpr{LHS: $1, RHS: $3}
@@ -604,6 +993,17 @@ expr :
 	| expr_binary
 	| expr_lets
 
+arrow_body :
+	expr %prec ARROW
+	{
+		$$ = &ast.ReturnStmt{Exprs: []ast.Expr{$1}}
+		$$.SetPosition($1.Position())
+	}
+	| ARROWBLOCK compstmt '}'
+	{
+		$$ = $2
+	}
+
 expr_idents :
 	{
 		$$ = []string{}
@@ -666,8 +1066,74 @@ type_data :
 			$$ = &ast.TypeStruct{Kind: ast.TypeChan, SubType: $2}
 		}
 	}
+	| type_data_struct
+	{
+		$$ = $1
+	}
+
+type_data_struct :
+	STRUCT '{' opt_newlines '}'
+	{
+		$$ = &ast.TypeStruct{Kind: ast.TypeStructType}
+	}
+	| STRUCT '{' opt_newlines type_data_struct_fields opt_term '}'
+	{
+		$$ = $4
+	}
+
+type_data_struct_fields :
+	IDENT type_data
+	{
+		$$ = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{$1.Lit}, StructTypes: []*ast.TypeStruct{$2}, StructTags: []string{""}}
+	}
+	| IDENT type_data STRING
+	{
+		$$ = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{$1.Lit}, StructTypes: []*ast.TypeStruct{$2}, StructTags: []string{$3.Lit}}
+	}
+	| type_data_struct_fields term IDENT type_data
+	{
+		for _, name := range $1.StructNames {
+			if name == $3.Lit {
+				yylex.Error("duplicate struct field " + name)
+				break
+			}
+		}
+		$1.StructNames = append($1.StructNames, $3.Lit)
+		$1.StructTypes = append($1.StructTypes, $4)
+		$1.StructTags = append($1.StructTags, "")
+	}
+	| type_data_struct_fields term IDENT type_data STRING
+	{
+		for _, name := range $1.StructNames {
+			if name == $3.Lit {
+				yylex.Error("duplicate struct field " + name)
+				break
+			}
+		}
+		$1.StructNames = append($1.StructNames, $3.Lit)
+		$1.StructTypes = append($1.StructTypes, $4)
+		$1.StructTags = append($1.StructTags, $5.Lit)
+	}
 
 
+type_data_list :
+	type_data
+	{
+		$$ = []*ast.TypeStruct{$1}
+	}
+	| NIL
+	{
+		$$ = []*ast.TypeStruct{nil}
+	}
+	| type_data_list ',' opt_newlines type_data
+	{
+		$$ = append($1, $4)
+	}
+	| type_data_list ',' opt_newlines NIL
+	{
+		$$ = append($1, nil)
+	}
+
 slice_count :
 	'[' ']'
 	{
@@ -691,7 +1157,12 @@ expr_member_or_ident :
 expr_member :
 	expr '.' IDENT
 	{
-		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit}
+		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit, Optional: isOptionalChain($1)}
+		$$.SetPosition($1.Position())
+	}
+	| expr OPTCHAIN IDENT
+	{
+		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit, Optional: true}
 		$$.SetPosition($1.Position())
 	}
 
@@ -726,6 +1197,10 @@ expr_literals :
 		$$ = &ast.LiteralExpr{Literal: stringToValue($1.Lit)}
 		$$.SetPosition($1.Position())
 	}
+	| INTERPOLATEDSTRING
+	{
+		$$ = $1
+	}
 	| TRUE
 	{
 		$$ = &ast.LiteralExpr{Literal: trueValue}
@@ -759,6 +1234,22 @@ expr_map :
 		$$.Keys = append($$.Keys, $4)
 		$$.Values = append($$.Values, $6)
 	}
+	| VARARG expr
+	{
+		spreadExpr := &ast.SpreadExpr{Expr: $2}
+		spreadExpr.SetPosition($1.Position())
+		$$ = &ast.MapExpr{Keys: []ast.Expr{spreadExpr}, Values: []ast.Expr{nil}}
+	}
+	| expr_map ',' opt_newlines VARARG expr
+	{
+		if $1.Keys == nil {
+			yylex.Error("syntax error: unexpected ','")
+		}
+		spreadExpr := &ast.SpreadExpr{Expr: $5}
+		spreadExpr.SetPosition($4.Position())
+		$$.Keys = append($$.Keys, spreadExpr)
+		$$.Values = append($$.Values, nil)
+	}
 
 expr_slice :
 	expr_ident '[' expr ':' expr ']'
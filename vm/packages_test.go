@@ -160,3 +160,33 @@ v2.Get("a")
 		t.Errorf("execute value - received: %#v expected: %#v", value, "")
 	}
 }
+
+func TestPackageRegistry(t *testing.T) {
+	registry := env.NewGlobalPackageRegistry()
+	registry.DenyPackages("os")
+	registry.DenySymbols("strings", "Repeat")
+	err := registry.Define("host", "Name", "host")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	tests := []Test{
+		{Script: `strings = import("strings"); strings.ToUpper("a")`, RunOutput: "A"},
+		{Script: `strings = import("strings"); strings.Repeat("a", 2)`, RunError: fmt.Errorf("undefined symbol 'Repeat'")},
+		{Script: `os = import("os")`, RunError: fmt.Errorf("package not allowed: os")},
+		{Script: `host = import("host"); host.Name`, RunOutput: "host"},
+		{Script: `func a() { return import("os") }; a()`, RunError: fmt.Errorf("package not allowed: os")},
+		{Script: `import("other")`, RunError: fmt.Errorf("package not found: other")},
+	}
+	setRegistry := func(t *testing.T, e *env.Env) { e.SetPackageRegistry(registry) }
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &setRegistry}, &Options{Debug: true})
+
+	// Envs without a PackageRegistry use the global packages
+	value, err := Execute(env.NewEnv(), nil, `os = import("os"); os.Getenv != nil`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != true {
+		t.Errorf("Execute value - received: %#v - expected: %#v", value, true)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/gbl08ma/anko/ast"
 	"github.com/gbl08ma/anko/env"
@@ -12,14 +15,38 @@ import (
 
 // Options provides options to run VM with
 type Options struct {
-	Debug bool // run in Debug mode
+	Debug        bool // run in Debug mode
+	StrictSwitch bool // switch cases only match values of the same type
+	Strict       bool // assignment to an undefined symbol is an error, variables must be declared. Also enabled by a "use strict" first statement
+
+	ModuleLoader *ModuleLoader // loads the script modules imported by path, if nil modules can not be imported
+
+	MaxSteps int64  // maximum number of statements and expressions run, including those of script functions. Zero for no limit
+	Steps    *int64 // if not nil, set to the number of steps run when the run returns
+
+	MaxContainerLength int64 // maximum length of the slices and maps made or grown by the script. Zero for no limit
+	MaxStringLength    int64 // maximum length in bytes of the strings made by the script. Zero for no limit
+	MaxAllocBytes      int64 // approximate maximum of the total bytes allocated by the script for containers and strings. Zero for no limit
+
+	MaxCallDepth int // maximum depth of nested script function calls. Zero for DefaultMaxCallDepth, negative for no limit
+
+	InterruptedDeferTimeout time.Duration // how long deferred calls can run after the run is interrupted. Zero for DefaultInterruptedDeferTimeout
+
+	Goroutines            GoroutineMode // what RunContext does with the goroutines started by go statements when the script returns
+	MaxGoroutines         int           // maximum number of goroutines started by go statements running at once. Zero for no limit
+	GoroutineErrorHandler func(error)   // if not nil, called with the error or panic of each goroutine started by a go statement that fails
 }
 
 type (
 	// Error is a VM run error.
 	Error struct {
-		Message string
-		Pos     ast.Position
+		Message  string
+		Pos      ast.Position
+		Filename string      // the file of the script module the error happened in, empty for the main script
+		Value    interface{} // the value given to throw, nil for other errors
+
+		err      error   // the error this error was made from
+		deferred []error // the errors of the deferred calls run after this error
 	}
 
 	// runInfo provides run incoming and outgoing information
@@ -31,10 +58,27 @@ type (
 		stmt     ast.Stmt
 		expr     ast.Expr
 		operator ast.Operator
+		module   *moduleFile // the script module being run, nil for the main script
+		limits   *runLimits  // the limits of the run, nil when there are none
+
+		callDepth  int             // the depth of the script function call being run, 0 for the main script
+		goroutines *goroutineGroup // the goroutines started by go statements of the run
 
 		// outgoing
-		rv  reflect.Value
-		err error
+		rv    reflect.Value
+		err   error
+		label string // label of break or continue statement when err is ErrBreak or ErrContinue
+
+		// calls added by defer statements, run when the function returns
+		deferredCalls []deferredCall
+	}
+
+	// deferredCall is a function call added by a defer statement
+	deferredCall struct {
+		f               reflect.Value
+		args            []reflect.Value
+		useCallSlice    bool
+		isRunVMFunction bool
 	}
 )
 
@@ -49,6 +93,8 @@ var (
 	errorType          = reflect.ValueOf([]error{nil}).Index(0).Type()
 	vmErrorType        = reflect.TypeOf(&Error{})
 	contextType        = reflect.TypeOf((*context.Context)(nil)).Elem()
+	yieldType          = reflect.TypeOf((func(interface{}) bool)(nil))
+	yield2Type         = reflect.TypeOf((func(interface{}, interface{}) bool)(nil))
 
 	nilValue                  = reflect.New(reflect.TypeOf((*interface{})(nil)).Elem()).Elem()
 	trueValue                 = reflect.ValueOf(true)
@@ -63,6 +109,8 @@ var (
 	ErrBreak = errors.New("unexpected break statement")
 	// ErrContinue when there is an unexpected continue statement
 	ErrContinue = errors.New("unexpected continue statement")
+	// ErrFallthrough when there is an unexpected fallthrough statement
+	ErrFallthrough = errors.New("unexpected fallthrough statement")
 	// ErrReturn when there is an unexpected return statement
 	ErrReturn = errors.New("unexpected return statement")
 	// ErrInterrupt when execution has been interrupted
@@ -71,7 +119,28 @@ var (
 
 // Error returns the VM error message.
 func (e *Error) Error() string {
-	return e.Message
+	if len(e.deferred) == 0 {
+		return e.Message
+	}
+	messages := make([]string, 0, 1+len(e.deferred))
+	messages = append(messages, e.Message)
+	for _, err := range e.deferred {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the error this error was made from, or the thrown value when it is an error,
+// followed by the errors of the deferred calls run after this error,
+// so errors.Is and errors.As can be used on VM errors.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, 1+len(e.deferred))
+	if e.err != nil {
+		errs = append(errs, e.err)
+	} else if err, ok := e.Value.(error); ok {
+		errs = append(errs, err)
+	}
+	return append(errs, e.deferred...)
 }
 
 // newError makes VM error from error
@@ -80,9 +149,9 @@ func newError(pos ast.Pos, err error) error {
 		return nil
 	}
 	if pos == nil {
-		return &Error{Message: err.Error(), Pos: ast.Position{Line: 1, Column: 1}}
+		return &Error{Message: err.Error(), Pos: ast.Position{Line: 1, Column: 1}, err: err}
 	}
-	return &Error{Message: err.Error(), Pos: pos.Position()}
+	return &Error{Message: err.Error(), Pos: pos.Position(), err: err}
 }
 
 // newStringError makes VM error from string
@@ -109,6 +178,18 @@ func isNil(v reflect.Value) bool {
 	}
 }
 
+// isType returns true when v is of type t, or implements t when t is an interface type.
+// A nil value is never of any type.
+func isType(v reflect.Value, t reflect.Type) bool {
+	if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+		return false
+	}
+	if t.Kind() == reflect.Interface {
+		return v.Type().Implements(t)
+	}
+	return v.Type() == t
+}
+
 func isNum(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -119,6 +200,25 @@ func isNum(v reflect.Value) bool {
 	return false
 }
 
+// strictEqual returns true when lhsV and rhsV are of the same type and are the same value.
+// Values with an Equal, Cmp or Compare method are compared with it, see equalWithMethods.
+func (runInfo *runInfoStruct) strictEqual(pos ast.Position, lhsV, rhsV reflect.Value) (bool, error) {
+	lhsIsNil, rhsIsNil := !lhsV.IsValid() || isNil(lhsV), !rhsV.IsValid() || isNil(rhsV)
+	if lhsIsNil || rhsIsNil {
+		return lhsIsNil && rhsIsNil, nil
+	}
+	if lhsV.Kind() == reflect.Interface {
+		lhsV = lhsV.Elem()
+	}
+	if rhsV.Kind() == reflect.Interface {
+		rhsV = rhsV.Elem()
+	}
+	if lhsV.Type() != rhsV.Type() {
+		return false, nil
+	}
+	return runInfo.equalWithMethods(pos, lhsV, rhsV)
+}
+
 // equal returns true when lhsV and rhsV is same value.
 func equal(lhsV, rhsV reflect.Value) bool {
 	lhsIsNil, rhsIsNil := isNil(lhsV), isNil(rhsV)
@@ -128,6 +228,17 @@ func equal(lhsV, rhsV reflect.Value) bool {
 	if (!lhsIsNil && rhsIsNil) || (lhsIsNil && !rhsIsNil) {
 		return false
 	}
+	if isBigNumber(lhsV) || isBigNumber(rhsV) {
+		lhsR, err := tryToBigRat(lhsV)
+		if err != nil {
+			return false
+		}
+		rhsR, err := tryToBigRat(rhsV)
+		if err != nil {
+			return false
+		}
+		return lhsR.Cmp(rhsR) == 0
+	}
 	if lhsV.Kind() == reflect.Interface || lhsV.Kind() == reflect.Ptr {
 		lhsV = lhsV.Elem()
 	}
@@ -343,6 +454,41 @@ func makeType(runInfo *runInfoStruct, typeStruct *ast.TypeStruct) reflect.Type {
 			return nil
 		}
 		return reflect.ChanOf(reflect.BothDir, t)
+	case ast.TypeStructType:
+		var t reflect.Type
+		fields := make([]reflect.StructField, 0, len(typeStruct.StructNames))
+		for i := 0; i < len(typeStruct.StructNames); i++ {
+			t = makeType(runInfo, typeStruct.StructTypes[i])
+			if runInfo.err != nil {
+				return nil
+			}
+			if t == nil {
+				return nil
+			}
+			name := typeStruct.StructNames[i]
+			if !unicode.IsUpper([]rune(name)[0]) {
+				runInfo.err = fmt.Errorf("struct field %v is not exported", name)
+				return nil
+			}
+			for j := 0; j < i; j++ {
+				if typeStruct.StructNames[j] == name {
+					runInfo.err = fmt.Errorf("duplicate struct field %v", name)
+					return nil
+				}
+			}
+			fields = append(fields, reflect.StructField{Name: name, Type: t, Tag: reflect.StructTag(typeStruct.StructTags[i])})
+		}
+		// capture panics if not in debug mode
+		defer func() {
+			if !runInfo.options.Debug {
+				if recoverResult := recover(); recoverResult != nil {
+					runInfo.err = fmt.Errorf("%v", recoverResult)
+					t = nil
+				}
+			}
+		}()
+		t = reflect.StructOf(fields)
+		return t
 	default:
 		runInfo.err = fmt.Errorf("unknown kind")
 		return nil
@@ -356,6 +502,11 @@ func getTypeFromEnv(runInfo *runInfoStruct, typeStruct *ast.TypeStruct) reflect.
 		return nil
 	}
 
+	if len(typeStruct.Env) > 0 && env.IsPrivate(typeStruct.Name) {
+		runInfo.err = &env.PrivateError{Symbol: typeStruct.Name}
+		return nil
+	}
+
 	var t reflect.Type
 	t, runInfo.err = e.Type(typeStruct.Name)
 	return t
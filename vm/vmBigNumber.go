@@ -0,0 +1,304 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/gbl08ma/anko/ast"
+)
+
+const (
+	// decimalPrecision is the number of digits after the decimal point used
+	// to convert a decimal that has no exact decimal representation into a string, like 1d / 3d.
+	decimalPrecision = 34
+
+	// maxBigShift is the largest count of a left shift of a big integer, so a shift cannot make a huge number
+	maxBigShift = 1 << 20
+)
+
+var (
+	bigIntType = reflect.TypeOf((*big.Int)(nil))
+	bigRatType = reflect.TypeOf((*big.Rat)(nil))
+
+	errDivisionByZero = errors.New("division by zero")
+	errShiftTooLarge  = errors.New("shift count too large")
+)
+
+// isBigNumber returns true if v is a non-nil *big.Int or *big.Rat
+func isBigNumber(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	return v.Type() == bigIntType || v.Type() == bigRatType
+}
+
+// isBigRat returns true if v is a non-nil *big.Rat
+func isBigRat(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v.IsValid() && v.Type() == bigRatType && !v.IsNil()
+}
+
+// toBigInt converts all reflect.Value-s into *big.Int.
+func toBigInt(v reflect.Value) *big.Int {
+	i, _ := tryToBigInt(v)
+	return i
+}
+
+// tryToBigInt attempts to convert a value to a *big.Int, truncating decimals and floats.
+// If it cannot it returns zero and an error.
+func tryToBigInt(v reflect.Value) (*big.Int, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if isBigNumber(v) {
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return n, nil
+		case *big.Rat:
+			return new(big.Int).Quo(n.Num(), n.Denom()), nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.Float64, reflect.Float32:
+		r, err := tryToBigRat(v)
+		if err != nil {
+			return new(big.Int), err
+		}
+		return new(big.Int).Quo(r.Num(), r.Denom()), nil
+	case reflect.String:
+		s := v.String()
+		var i *big.Int
+		var ok bool
+		if strings.HasPrefix(s, "0x") {
+			i, ok = new(big.Int).SetString(s[2:], 16)
+		} else {
+			i, ok = new(big.Int).SetString(s, 10)
+		}
+		if ok {
+			return i, nil
+		}
+		return new(big.Int), errors.New("couldn't convert to big integer")
+	}
+	i, err := tryToInt64(v)
+	return big.NewInt(i), err
+}
+
+// toBigRat converts all reflect.Value-s into *big.Rat.
+func toBigRat(v reflect.Value) *big.Rat {
+	r, _ := tryToBigRat(v)
+	return r
+}
+
+// tryToBigRat attempts to convert a value to a *big.Rat.
+// If it cannot it returns zero and an error.
+func tryToBigRat(v reflect.Value) (*big.Rat, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if isBigNumber(v) {
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return new(big.Rat).SetInt(n), nil
+		case *big.Rat:
+			return n, nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.Float64, reflect.Float32:
+		r := new(big.Rat).SetFloat64(v.Float())
+		if r == nil {
+			return new(big.Rat), errors.New("couldn't convert to decimal")
+		}
+		return r, nil
+	case reflect.String:
+		r, ok := new(big.Rat).SetString(v.String())
+		if ok {
+			return r, nil
+		}
+		return new(big.Rat), errors.New("couldn't convert to decimal")
+	}
+	i, err := tryToInt64(v)
+	return new(big.Rat).SetInt64(i), err
+}
+
+// DecimalString returns the decimal representation of r.
+// It is exact when possible, otherwise it is rounded to decimalPrecision digits after the decimal point.
+// Decimals are fractions that do not keep the scale of their literal, so trailing zeros are not kept: 12.30d is 12.3.
+func DecimalString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	// the decimal representation is exact when the denominator only has 2 and 5 as prime factors,
+	// in which case the number of digits needed is the highest power of the two
+	denom := new(big.Int).Set(r.Denom())
+	remainder := new(big.Int)
+	var twos, fives int
+	for denom.Bit(0) == 0 {
+		denom.Rsh(denom, 1)
+		twos++
+	}
+	five := big.NewInt(5)
+	for {
+		quotient, _ := new(big.Int).QuoRem(denom, five, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		denom = quotient
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return r.FloatString(decimalPrecision)
+	}
+	if twos > fives {
+		return r.FloatString(twos)
+	}
+	return r.FloatString(fives)
+}
+
+// bigNumberOperator does the operator on the values when at least one of them is a big number.
+// The result is a *big.Rat if either value is a *big.Rat or a float, otherwise it is a *big.Int.
+// Division always results in a *big.Rat, like division of integers results in a float64.
+func bigNumberOperator(operator string, lhsV reflect.Value, rhsV reflect.Value) (reflect.Value, error) {
+	if lhsV.Kind() == reflect.Interface && !lhsV.IsNil() {
+		lhsV = lhsV.Elem()
+	}
+	if rhsV.Kind() == reflect.Interface && !rhsV.IsNil() {
+		rhsV = rhsV.Elem()
+	}
+
+	switch operator {
+	case "+", "-", "*":
+		if isBigRat(lhsV) || isBigRat(rhsV) || isFloat(lhsV) || isFloat(rhsV) {
+			lhs, rhs := toBigRat(lhsV), toBigRat(rhsV)
+			switch operator {
+			case "+":
+				return reflect.ValueOf(new(big.Rat).Add(lhs, rhs)), nil
+			case "-":
+				return reflect.ValueOf(new(big.Rat).Sub(lhs, rhs)), nil
+			}
+			return reflect.ValueOf(new(big.Rat).Mul(lhs, rhs)), nil
+		}
+		lhs, rhs := toBigInt(lhsV), toBigInt(rhsV)
+		switch operator {
+		case "+":
+			return reflect.ValueOf(new(big.Int).Add(lhs, rhs)), nil
+		case "-":
+			return reflect.ValueOf(new(big.Int).Sub(lhs, rhs)), nil
+		}
+		return reflect.ValueOf(new(big.Int).Mul(lhs, rhs)), nil
+
+	case "/":
+		rhs := toBigRat(rhsV)
+		if rhs.Sign() == 0 {
+			return nilValue, errDivisionByZero
+		}
+		return reflect.ValueOf(new(big.Rat).Quo(toBigRat(lhsV), rhs)), nil
+
+	case "%":
+		rhs := toBigInt(rhsV)
+		if rhs.Sign() == 0 {
+			return nilValue, errDivisionByZero
+		}
+		return reflect.ValueOf(new(big.Int).Rem(toBigInt(lhsV), rhs)), nil
+
+	case "|":
+		return reflect.ValueOf(new(big.Int).Or(toBigInt(lhsV), toBigInt(rhsV))), nil
+	case "&":
+		return reflect.ValueOf(new(big.Int).And(toBigInt(lhsV), toBigInt(rhsV))), nil
+	case "<<", ">>":
+		shift := bigShiftCount(rhsV)
+		if shift < 0 {
+			return nilValue, errors.New("negative shift count")
+		}
+		if operator == "<<" {
+			if shift > maxBigShift {
+				return nilValue, errShiftTooLarge
+			}
+			return reflect.ValueOf(new(big.Int).Lsh(toBigInt(lhsV), uint(shift))), nil
+		}
+		return reflect.ValueOf(new(big.Int).Rsh(toBigInt(lhsV), uint(shift))), nil
+	}
+
+	return nilValue, errors.New("unknown operator")
+}
+
+// runBigNumberOperator does the operator on the values with bigNumberOperator.
+// The approximate bytes of the result are first added to the bytes allocated, as big numbers can grow without bound.
+func (runInfo *runInfoStruct) runBigNumberOperator(pos ast.Pos, operator string, lhsV reflect.Value, rhsV reflect.Value) {
+	runInfo.err = runInfo.allocate(pos, bigNumberResultBytes(operator, lhsV, rhsV))
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+		return
+	}
+	runInfo.rv, runInfo.err = bigNumberOperator(operator, lhsV, rhsV)
+	if runInfo.err != nil {
+		runInfo.err = newError(pos, runInfo.err)
+	}
+}
+
+// bigNumberResultBytes returns an upper bound of the bytes of the result of the operator on the values
+func bigNumberResultBytes(operator string, lhsV reflect.Value, rhsV reflect.Value) int64 {
+	switch operator {
+	case "<<":
+		shift := bigShiftCount(rhsV)
+		if shift < 0 || shift > maxBigShift {
+			// bigNumberOperator returns an error
+			return 0
+		}
+		return (bigNumberBits(lhsV) + shift) / 8
+	case ">>":
+		return bigNumberBits(lhsV) / 8
+	}
+	// the sum of the operands is enough for multiplication and the operations on fractions
+	return (bigNumberBits(lhsV) + bigNumberBits(rhsV)) / 8
+}
+
+// bigNumberBits returns the number of bits of a big number, or of an int64 for other values
+func bigNumberBits(v reflect.Value) int64 {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if isBigNumber(v) {
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return int64(n.BitLen())
+		case *big.Rat:
+			return int64(n.Num().BitLen() + n.Denom().BitLen())
+		}
+	}
+	return 64
+}
+
+// bigShiftCount returns the shift count of a shift of a big integer
+func bigShiftCount(rhsV reflect.Value) int64 {
+	if isBigNumber(rhsV) {
+		shift := toBigInt(rhsV)
+		if !shift.IsInt64() {
+			if shift.Sign() < 0 {
+				return -1
+			}
+			return maxBigShift + 1
+		}
+		return shift.Int64()
+	}
+	return toInt64(rhsV)
+}
+
+// compareBigNumbers compares the values when at least one of them is a big number,
+// returning -1, 0 or +1 like big.Rat Cmp
+func compareBigNumbers(lhsV reflect.Value, rhsV reflect.Value) int {
+	return toBigRat(lhsV).Cmp(toBigRat(rhsV))
+}
+
+// isFloat returns true if v is a float64 or float32
+func isFloat(v reflect.Value) bool {
+	return v.Kind() == reflect.Float64 || v.Kind() == reflect.Float32
+}
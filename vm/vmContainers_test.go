@@ -1121,6 +1121,36 @@ func TestMaps(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestSpreadLiterals(t *testing.T) {
+	tests := []Test{
+		{Script: `a = [1, 2]; [a...]`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a = [1, 2]; [0, a..., 3, a...]`, RunOutput: []interface{}{int64(0), int64(1), int64(2), int64(3), int64(1), int64(2)}},
+		{Script: `[[]..., nil..., 1]`, RunOutput: []interface{}{int64(1)}},
+		{Script: `[a..., "c"]`, Input: map[string]interface{}{"a": []string{"a", "b"}}, RunOutput: []interface{}{"a", "b", "c"}},
+		{Script: `[1, a...]`, Input: map[string]interface{}{"a": [2]int64{2, 3}}, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `a = [1, 2]; []int64{a..., 3}`, RunOutput: []int64{1, 2, 3}},
+		{Script: `a = [1.1, 2.2]; []int64{a..., 3}`, RunOutput: []int64{1, 2, 3}},
+		{Script: `a = [1, "b"]; []int64{a...}`, RunError: fmt.Errorf("cannot use type string as type int64 as slice value")},
+		{Script: `[true...]`, RunError: fmt.Errorf("cannot spread type bool")},
+		{Script: `[{}...]`, RunError: fmt.Errorf("cannot spread type map[interface {}]interface {}")},
+
+		{Script: `a = {"a": 1, "b": 2}; {...a}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = {"a": 1, "b": 2}; {...a, "b": 3, "c": 4}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(3), "c": int64(4)}},
+		{Script: `a = {"a": 1, "b": 2}; {"b": 3, "c": 4, ...a}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2), "c": int64(4)}},
+		{Script: `a = {"a": 1}; b = {"b": 2}; {...a, ...b, ...nil}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `{
+...a,
+"b": 2,
+}`, Input: map[string]interface{}{"a": map[string]int64{"a": 1}}, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = {"a": 1.5}; map[string]int64{...a, "b": 2}`, RunOutput: map[string]int64{"a": 1, "b": 2}},
+		{Script: `a = {true: 1}; map[string]int64{...a}`, RunError: fmt.Errorf("cannot use type bool as type string as map key")},
+		{Script: `a = {"a": "a"}; map[string]int64{...a}`, RunError: fmt.Errorf("cannot use type string as type int64 as map value")},
+		{Script: `{...[1]}`, RunError: fmt.Errorf("cannot spread type []interface {}")},
+		{Script: `{..."a": 1}`, ParseError: fmt.Errorf("syntax error")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestExistenceOfKeyInMaps(t *testing.T) {
 	tests := []Test{
 		{Script: `a = {"b":"b"}; v, ok = a[1++]`, RunError: fmt.Errorf("invalid operation")},
@@ -1804,3 +1834,52 @@ func TestMakeStructs(t *testing.T) {
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestStructTypes(t *testing.T) {
+	tests := []Test{
+		{Script: `make(struct { A int64; A string })`, ParseError: fmt.Errorf("duplicate struct field A"), RunError: fmt.Errorf("duplicate struct field A")},
+		{Script: `make(struct { a int64 })`, RunError: fmt.Errorf("struct field a is not exported")},
+		{Script: `make(struct { A a })`, RunError: fmt.Errorf("undefined type 'a'")},
+		{Script: `a = make(struct { A int64 }); a.A = "b"`, RunError: fmt.Errorf("type string cannot be assigned to type int64 for struct")},
+
+		{Script: `make(struct { })`, RunOutput: struct{}{}},
+		{Script: `make(struct { A int64 })`, RunOutput: struct{ A int64 }{}},
+		{Script: `make(struct { A int64; B string })`, RunOutput: struct {
+			A int64
+			B string
+		}{}},
+		{Script: `make(struct {
+	A int64
+	B string
+})`, RunOutput: struct {
+			A int64
+			B string
+		}{}},
+		{Script: `new(struct { A int64 })`, RunOutput: &struct{ A int64 }{}},
+		{Script: `make([]struct { A int64 }, 2)`, RunOutput: []struct{ A int64 }{{}, {}}},
+		{Script: `make(struct { A []int64; B map[string]bool })`, RunOutput: struct {
+			A []int64
+			B map[string]bool
+		}{A: []int64{}, B: map[string]bool{}}},
+
+		{Script: `a = make(struct { A int64; B string }); a.A = 1; a.B = "b"; a`, RunOutput: struct {
+			A int64
+			B string
+		}{A: 1, B: "b"}},
+		{Script: `a = new(struct { A int64 }); a.A = 1; a.A`, RunOutput: int64(1)},
+		{Script: `a = make(struct { A struct { B int64 } }); a.A.B = 1; a`, RunOutput: struct{ A struct{ B int64 } }{A: struct{ B int64 }{B: 1}}},
+		{Script: `a = make(struct { A []int64 }); a.A += 1; a.A`, RunOutput: []int64{1}},
+
+		{Script: `make(type a, struct { A int64 })`, RunOutput: reflect.TypeOf(struct{ A int64 }{})},
+		{Script: `make(type a, struct { A int64 ` + "`json:\"a\"`" + ` }); b = make(a); b.A = 1; b`, RunOutput: struct {
+			A int64 `json:"a"`
+		}{A: 1}},
+		{Script: `make(type a, struct { A int64 }); b = new(a); b.A = 1; *b`, RunOutput: struct{ A int64 }{A: 1}},
+		{Script: `make(type a, struct { A int64 }); b = make([]a, 1); b`, RunOutput: []struct{ A int64 }{{}}},
+		{Script: `make(type a, struct { A int64 }); b = make(struct { C a }); b.C.A = 1; b.C`, RunOutput: struct{ A int64 }{A: 1}},
+
+		{Script: `struct = 1; struct + 1`, RunOutput: int64(2), Output: map[string]interface{}{"struct": int64(1)}},
+		{Script: `func a(struct) { return struct }; a(1)`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
@@ -194,7 +194,7 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		// returns normal VM reflect.Value form
 		rv, err := processCallReturnValues(rvs, true, false)
 		if err != nil {
-			panic("function run error: " + err.Error())
+			panic(fmt.Errorf("function run error: %w", err))
 		}
 
 		if rt.NumOut() < 1 {
@@ -2,7 +2,9 @@ package vm
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 
 	"github.com/gbl08ma/anko/ast"
 	"github.com/gbl08ma/anko/env"
@@ -10,6 +12,12 @@ import (
 
 // invokeExpr evaluates one expression.
 func (runInfo *runInfoStruct) invokeExpr() {
+	if runInfo.limits != nil && !runInfo.limits.step() {
+		runInfo.err = newError(runInfo.expr, ErrStepLimit)
+		runInfo.rv = nilValue
+		return
+	}
+
 	switch expr := runInfo.expr.(type) {
 
 	// OpExpr
@@ -28,12 +36,45 @@ func (runInfo *runInfoStruct) invokeExpr() {
 	case *ast.LiteralExpr:
 		runInfo.rv = expr.Literal
 
+	// InterpolatedStringExpr
+	case *ast.InterpolatedStringExpr:
+		var builder strings.Builder
+		for i := 0; i < len(expr.Exprs); i++ {
+			builder.WriteString(expr.Strings[i])
+			runInfo.expr = expr.Exprs[i]
+			runInfo.invokeExpr()
+			if runInfo.err != nil {
+				return
+			}
+			builder.WriteString(toString(runInfo.rv))
+		}
+		builder.WriteString(expr.Strings[len(expr.Exprs)])
+		runInfo.err = runInfo.checkStringLength(expr, int64(builder.Len()))
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+		runInfo.rv = reflect.ValueOf(builder.String())
+
 	// ArrayExpr
 	case *ast.ArrayExpr:
+		exprs := expr.Exprs
+		if hasSpreadExpr(exprs) {
+			exprs = runInfo.expandSpreadExprs(exprs)
+			if runInfo.err != nil {
+				return
+			}
+		}
+
 		if expr.TypeData == nil {
-			slice := make([]interface{}, len(expr.Exprs))
+			runInfo.err = runInfo.checkContainerLength(expr, int64(len(exprs)), interfaceSize)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+			slice := make([]interface{}, len(exprs))
 			var i int
-			for i, runInfo.expr = range expr.Exprs {
+			for i, runInfo.expr = range exprs {
 				runInfo.invokeExpr()
 				if runInfo.err != nil {
 					return
@@ -55,10 +96,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		slice := reflect.MakeSlice(t, len(expr.Exprs), len(expr.Exprs))
+		runInfo.err = runInfo.checkContainerLength(expr, int64(len(exprs)), typeSize(t.Elem()))
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+		slice := reflect.MakeSlice(t, len(exprs), len(exprs))
 		var i int
 		valueType := t.Elem()
-		for i, runInfo.expr = range expr.Exprs {
+		for i, runInfo.expr = range exprs {
 			runInfo.invokeExpr()
 			if runInfo.err != nil {
 				return
@@ -78,10 +124,23 @@ func (runInfo *runInfoStruct) invokeExpr() {
 	// MapExpr
 	case *ast.MapExpr:
 		if expr.TypeData == nil {
+			runInfo.err = runInfo.checkContainerLength(expr, int64(len(expr.Keys)), 2*interfaceSize)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
 			var i int
 			var key reflect.Value
 			m := make(map[interface{}]interface{}, len(expr.Keys))
 			for i, runInfo.expr = range expr.Keys {
+				if spreadExpr, ok := runInfo.expr.(*ast.SpreadExpr); ok {
+					runInfo.spreadMapExpr(spreadExpr, reflect.ValueOf(m))
+					if runInfo.err != nil {
+						return
+					}
+					continue
+				}
+
 				runInfo.invokeExpr()
 				if runInfo.err != nil {
 					return
@@ -111,6 +170,14 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		if t.Kind() == reflect.Map {
+			runInfo.err = runInfo.checkContainerLength(expr, int64(len(expr.Keys)), typeSize(t.Key())+typeSize(t.Elem()))
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+		}
+
 		runInfo.rv, runInfo.err = makeValue(t)
 		if runInfo.err != nil {
 			runInfo.rv = nilValue
@@ -123,6 +190,14 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		keyType := t.Key()
 		valueType := t.Elem()
 		for i, runInfo.expr = range expr.Keys {
+			if spreadExpr, ok := runInfo.expr.(*ast.SpreadExpr); ok {
+				runInfo.spreadMapExpr(spreadExpr, m)
+				if runInfo.err != nil {
+					return
+				}
+				continue
+			}
+
 			runInfo.invokeExpr()
 			if runInfo.err != nil {
 				return
@@ -150,6 +225,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		}
 		runInfo.rv = m
 
+	// SpreadExpr
+	case *ast.SpreadExpr:
+		runInfo.err = newStringError(expr, "spread can only be used in function calls and array and map literals")
+		runInfo.rv = nilValue
+
+	// ComprehensionExpr
+	case *ast.ComprehensionExpr:
+		runInfo.comprehensionExpr(expr)
+
 	// DerefExpr
 	case *ast.DerefExpr:
 		runInfo.expr = expr.Expr
@@ -190,6 +274,14 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 		switch expr.Operator {
 		case "-":
+			if isBigNumber(runInfo.rv) {
+				if isBigRat(runInfo.rv) {
+					runInfo.rv = reflect.ValueOf(new(big.Rat).Neg(toBigRat(runInfo.rv)))
+				} else {
+					runInfo.rv = reflect.ValueOf(new(big.Int).Neg(toBigInt(runInfo.rv)))
+				}
+				return
+			}
 			switch runInfo.rv.Kind() {
 			case reflect.Int64:
 				runInfo.rv = reflect.ValueOf(-runInfo.rv.Int())
@@ -201,6 +293,10 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				runInfo.rv = reflect.ValueOf(-toFloat64(runInfo.rv))
 			}
 		case "^":
+			if isBigNumber(runInfo.rv) {
+				runInfo.rv = reflect.ValueOf(new(big.Int).Not(toBigInt(runInfo.rv)))
+				return
+			}
 			runInfo.rv = reflect.ValueOf(^toInt64(runInfo.rv))
 		case "!":
 			if toBool(runInfo.rv) {
@@ -233,8 +329,14 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if expr.Optional && isNil(runInfo.rv) {
+			// optional chain, nil instead of an error
+			runInfo.rv = nilValue
+			return
+		}
+
 		if env, ok := runInfo.rv.Interface().(*env.Env); ok {
-			runInfo.rv, runInfo.err = env.GetValue(expr.Name)
+			runInfo.rv, runInfo.err = env.GetMemberValue(expr.Name)
 			if runInfo.err != nil {
 				runInfo.err = newError(expr, runInfo.err)
 				runInfo.rv = nilValue
@@ -242,7 +344,13 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		value := runInfo.rv.MethodByName(expr.Name)
+		value, found := runInfo.getMethod(runInfo.rv, expr.Name)
+		if found {
+			runInfo.rv = value
+			return
+		}
+
+		value = runInfo.rv.MethodByName(expr.Name)
 		if value.IsValid() {
 			runInfo.rv = value
 			return
@@ -285,16 +393,22 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		}
 		item := runInfo.rv
 
+		if item.Kind() == reflect.Interface && !item.IsNil() {
+			item = item.Elem()
+		}
+
+		if expr.Optional && isNil(item) {
+			// optional chain, nil instead of an error and the index is not evaluated
+			runInfo.rv = nilValue
+			return
+		}
+
 		runInfo.expr = expr.Index
 		runInfo.invokeExpr()
 		if runInfo.err != nil {
 			return
 		}
 
-		if item.Kind() == reflect.Interface && !item.IsNil() {
-			item = item.Elem()
-		}
-
 		switch item.Kind() {
 		case reflect.String, reflect.Slice, reflect.Array:
 			var index int
@@ -512,12 +626,21 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		name := runInfo.rv.String()
 		runInfo.rv = nilValue
 
-		methods, ok := env.Packages[name]
-		if !ok {
-			runInfo.err = newStringError(expr, "package not found: "+name)
+		if isModulePath(name) {
+			var moduleEnv *env.Env
+			moduleEnv, runInfo.err = runInfo.importModule(expr, name)
+			if runInfo.err != nil {
+				return
+			}
+			runInfo.rv = reflect.ValueOf(moduleEnv)
+			return
+		}
+
+		methods, types, err := runInfo.env.Package(name)
+		if err != nil {
+			runInfo.err = newError(expr, err)
 			return
 		}
-		var err error
 		pack := runInfo.env.NewEnv()
 		for methodName, methodValue := range methods {
 			err = pack.DefineValue(methodName, methodValue)
@@ -527,19 +650,58 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			}
 		}
 
-		types, ok := env.PackageTypes[name]
-		if ok {
-			for typeName, typeValue := range types {
-				err = pack.DefineReflectType(typeName, typeValue)
-				if err != nil {
-					runInfo.err = newStringError(expr, "import DefineReflectType error: "+err.Error())
-					return
-				}
+		for typeName, typeValue := range types {
+			err = pack.DefineReflectType(typeName, typeValue)
+			if err != nil {
+				runInfo.err = newStringError(expr, "import DefineReflectType error: "+err.Error())
+				return
 			}
 		}
 
 		runInfo.rv = reflect.ValueOf(pack)
 
+	// TypeAssertExpr
+	case *ast.TypeAssertExpr:
+		runInfo.expr = expr.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		value := runInfo.rv
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+
+		t := makeType(runInfo, expr.TypeData)
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+		if t == nil {
+			runInfo.err = newStringError(expr, "cannot assert type nil")
+			runInfo.rv = nilValue
+			return
+		}
+
+		ok := isType(value, t)
+		if expr.Ok {
+			if !ok {
+				value = reflect.Zero(t)
+			}
+			runInfo.rv = reflect.ValueOf([]interface{}{value.Interface(), ok})
+			return
+		}
+		if !ok {
+			if isNil(value) || !value.IsValid() {
+				runInfo.err = newStringError(expr, "type assertion failed: nil is not "+t.String())
+			} else {
+				runInfo.err = newStringError(expr, "type assertion failed: "+value.Type().String()+" is not "+t.String())
+			}
+			runInfo.rv = nilValue
+			return
+		}
+		runInfo.rv = value
+
 	// MakeExpr
 	case *ast.MakeExpr:
 		t := makeType(runInfo, expr.TypeData)
@@ -578,6 +740,11 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				runInfo.rv = nilValue
 				return
 			}
+			runInfo.err = runInfo.checkContainerLength(expr, int64(cap), typeSize(t.Elem()))
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
 			runInfo.rv = reflect.MakeSlice(t, aLen, cap)
 			return
 		case ast.TypeChan:
@@ -590,6 +757,11 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				}
 				aLen = toInt(runInfo.rv)
 			}
+			runInfo.err = runInfo.checkContainerLength(expr, int64(aLen), typeSize(t.Elem()))
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
 			runInfo.rv = reflect.MakeChan(t, aLen)
 			return
 		}
@@ -598,16 +770,31 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 	// MakeTypeExpr
 	case *ast.MakeTypeExpr:
-		runInfo.expr = expr.Type
-		runInfo.invokeExpr()
-		if runInfo.err != nil {
-			return
+		var t reflect.Type
+		if expr.TypeData != nil {
+			t = makeType(runInfo, expr.TypeData)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+			if t == nil {
+				runInfo.err = newStringError(expr, "cannot make type nil")
+				runInfo.rv = nilValue
+				return
+			}
+		} else {
+			runInfo.expr = expr.Type
+			runInfo.invokeExpr()
+			if runInfo.err != nil {
+				return
+			}
+			t = runInfo.rv.Type()
 		}
 
 		// if expr.Name has a dot in it, it should give a syntax error, so no needs to check err
-		runInfo.env.DefineReflectType(expr.Name, runInfo.rv.Type())
+		runInfo.env.DefineReflectType(expr.Name, t)
 
-		runInfo.rv = reflect.ValueOf(runInfo.rv.Type())
+		runInfo.rv = reflect.ValueOf(t)
 
 	// ChanExpr
 	case *ast.ChanExpr:
@@ -737,8 +924,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		for i := 0; i < runInfo.rv.Len(); i++ {
-			if equal(itemExpr, runInfo.rv.Index(i)) {
+		listExpr := runInfo.rv
+		for i := 0; i < listExpr.Len(); i++ {
+			var match bool
+			match, runInfo.err = runInfo.equalWithMethods(expr.Position(), itemExpr, listExpr.Index(i))
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+			if match {
 				runInfo.rv = trueValue
 				return
 			}
@@ -751,3 +945,205 @@ func (runInfo *runInfoStruct) invokeExpr() {
 	}
 
 }
+
+// hasSpreadExpr returns true if any of the expressions is a spread
+func hasSpreadExpr(exprs []ast.Expr) bool {
+	for _, expr := range exprs {
+		if _, ok := expr.(*ast.SpreadExpr); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// expandSpreadExprs evaluates the expressions in order, expanding the items of spreads.
+// Each value is returned as a literal expression with the position of the expression it came from.
+func (runInfo *runInfoStruct) expandSpreadExprs(exprs []ast.Expr) []ast.Expr {
+	expanded := make([]ast.Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		spreadExpr, isSpread := expr.(*ast.SpreadExpr)
+		if isSpread {
+			runInfo.expr = spreadExpr.Expr
+		} else {
+			runInfo.expr = expr
+		}
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return nil
+		}
+
+		if !isSpread {
+			literalExpr := &ast.LiteralExpr{Literal: runInfo.rv}
+			literalExpr.SetPosition(expr.Position())
+			expanded = append(expanded, literalExpr)
+			continue
+		}
+
+		if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+			runInfo.rv = runInfo.rv.Elem()
+		}
+		if runInfo.rv.Kind() == reflect.Interface {
+			// spread of nil adds nothing
+			continue
+		}
+		if runInfo.rv.Kind() != reflect.Slice && runInfo.rv.Kind() != reflect.Array {
+			runInfo.err = newStringError(spreadExpr, "cannot spread type "+runInfo.rv.Type().String())
+			runInfo.rv = nilValue
+			return nil
+		}
+		for i := 0; i < runInfo.rv.Len(); i++ {
+			literalExpr := &ast.LiteralExpr{Literal: runInfo.rv.Index(i)}
+			literalExpr.SetPosition(spreadExpr.Position())
+			expanded = append(expanded, literalExpr)
+		}
+	}
+	return expanded
+}
+
+// spreadMapExpr sets the keys and values of the spread map into the map m,
+// converting them to the key and value types of m
+func (runInfo *runInfoStruct) spreadMapExpr(spreadExpr *ast.SpreadExpr, m reflect.Value) {
+	runInfo.expr = spreadExpr.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return
+	}
+
+	if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+		runInfo.rv = runInfo.rv.Elem()
+	}
+	if runInfo.rv.Kind() == reflect.Interface {
+		// spread of nil adds nothing
+		return
+	}
+	if runInfo.rv.Kind() != reflect.Map {
+		runInfo.err = newStringError(spreadExpr, "cannot spread type "+runInfo.rv.Type().String())
+		runInfo.rv = nilValue
+		return
+	}
+
+	keyType := m.Type().Key()
+	valueType := m.Type().Elem()
+	spreadMap := runInfo.rv
+	for _, spreadKey := range spreadMap.MapKeys() {
+		spreadValue := spreadMap.MapIndex(spreadKey)
+		if spreadKey.Kind() == reflect.Interface && !spreadKey.IsNil() {
+			spreadKey = spreadKey.Elem()
+		}
+		if spreadValue.Kind() == reflect.Interface && !spreadValue.IsNil() {
+			spreadValue = spreadValue.Elem()
+		}
+
+		key, err := convertReflectValueToType(spreadKey, keyType)
+		if err != nil {
+			runInfo.err = newStringError(spreadExpr, "cannot use type "+spreadKey.Type().String()+" as type "+keyType.String()+" as map key")
+			runInfo.rv = nilValue
+			return
+		}
+		value, err := convertReflectValueToType(spreadValue, valueType)
+		if err != nil {
+			runInfo.err = newStringError(spreadExpr, "cannot use type "+spreadValue.Type().String()+" as type "+valueType.String()+" as map value")
+			runInfo.rv = nilValue
+			return
+		}
+		if !m.MapIndex(key).IsValid() {
+			runInfo.err = runInfo.checkContainerLength(spreadExpr, int64(m.Len()+1), typeSize(keyType)+typeSize(valueType))
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+		}
+		m.SetMapIndex(key, value)
+	}
+	runInfo.rv = m
+}
+
+// comprehensionStmt is the body of the for in loop that runs a comprehension,
+// adding the values of each iteration to the list or map.
+type comprehensionStmt struct {
+	ast.StmtImpl
+	expr *ast.ComprehensionExpr
+	list []interface{}
+	m    map[interface{}]interface{}
+}
+
+// comprehensionExpr runs a comprehension using a for in loop,
+// so it can loop over the same types and the loop variables are in a new child env.
+func (runInfo *runInfoStruct) comprehensionExpr(expr *ast.ComprehensionExpr) {
+	if len(expr.Vars) < 1 || len(expr.Vars) > 2 {
+		runInfo.err = newStringError(expr, "comprehension needs one or two identifiers")
+		runInfo.rv = nilValue
+		return
+	}
+
+	stmt := &comprehensionStmt{expr: expr}
+	stmt.SetPosition(expr.Position())
+	if expr.Key == nil {
+		stmt.list = make([]interface{}, 0)
+	} else {
+		stmt.m = make(map[interface{}]interface{})
+	}
+
+	forStmt := &ast.ForStmt{Vars: expr.Vars, Value: expr.Range, Stmt: stmt}
+	forStmt.SetPosition(expr.Position())
+
+	runInfo.stmt = forStmt
+	runInfo.runSingleStmt()
+	if runInfo.err != nil {
+		return
+	}
+
+	if expr.Key == nil {
+		runInfo.rv = reflect.ValueOf(stmt.list)
+	} else {
+		runInfo.rv = reflect.ValueOf(stmt.m)
+	}
+}
+
+// runComprehensionStmt adds the values of one iteration of a comprehension when the condition is true
+func (runInfo *runInfoStruct) runComprehensionStmt(stmt *comprehensionStmt) {
+	if stmt.expr.Cond != nil {
+		runInfo.expr = stmt.expr.Cond
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		if !toBool(runInfo.rv) {
+			runInfo.rv = nilValue
+			return
+		}
+	}
+
+	var key reflect.Value
+	if stmt.expr.Key != nil {
+		runInfo.expr = stmt.expr.Key
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		key = runInfo.rv
+	}
+
+	runInfo.expr = stmt.expr.Value
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return
+	}
+
+	if stmt.expr.Key == nil {
+		runInfo.err = runInfo.checkContainerLength(stmt.expr, int64(len(stmt.list)+1), interfaceSize)
+	} else {
+		runInfo.err = runInfo.checkContainerLength(stmt.expr, int64(len(stmt.m)+1), 2*interfaceSize)
+	}
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+		return
+	}
+
+	if stmt.expr.Key == nil {
+		stmt.list = append(stmt.list, runInfo.rv.Interface())
+	} else {
+		stmt.m[key.Interface()] = runInfo.rv.Interface()
+	}
+	runInfo.rv = nilValue
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -34,7 +35,18 @@ func (runInfo *runInfoStruct) funcExpr() {
 	// returns slice of reflect.Type with two values:
 	// return value of the function and error value of the run
 	runVMFunction := func(in []reflect.Value) []reflect.Value {
-		runInfo := runInfoStruct{ctx: in[0].Interface().(context.Context), options: runInfo.options, env: envFunc.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue}
+		runInfo := runInfoStruct{ctx: in[0].Interface().(context.Context), options: runInfo.options, env: envFunc.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue, module: runInfo.module, limits: runInfo.limits}
+		if limits := runLimitsFromContext(runInfo.ctx); limits != nil {
+			// called from a run with limits, otherwise the limits of the run that defined the function are used
+			runInfo.limits = limits
+		}
+		runInfo.goroutines = goroutineGroupFromContext(runInfo.ctx)
+		var depth *callDepth
+		depth, runInfo.err = runInfo.enterCall()
+		if runInfo.err != nil {
+			return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(newError(funcExpr, runInfo.err)))}
+		}
+		defer func(callerDepth int) { depth.depth = callerDepth }(runInfo.callDepth - 1)
 
 		// add Params to newEnv, except last Params
 		for i := 0; i < len(funcExpr.Params)-1; i++ {
@@ -56,6 +68,7 @@ func (runInfo *runInfoStruct) funcExpr() {
 
 		// run function statements
 		runInfo.runSingleStmt()
+		runInfo.runDeferredCalls()
 		if runInfo.err != nil && runInfo.err != ErrReturn {
 			runInfo.err = newError(funcExpr, runInfo.err)
 			// return nil value and error
@@ -72,12 +85,103 @@ func (runInfo *runInfoStruct) funcExpr() {
 	// make the reflect.Value function that calls runVMFunction
 	runInfo.rv = reflect.MakeFunc(funcType, runVMFunction)
 
+	// if function has a receiver, define it as a method of the receiver type
+	if funcExpr.Receiver != nil {
+		t := makeType(runInfo, funcExpr.Receiver)
+		if runInfo.err != nil {
+			runInfo.err = newError(funcExpr, runInfo.err)
+			runInfo.rv = nilValue
+			return
+		}
+		if t == nil {
+			runInfo.err = newStringError(funcExpr, "cannot define method on type nil")
+			runInfo.rv = nilValue
+			return
+		}
+		runInfo.env.DefineMethod(t, funcExpr.Name, runInfo.rv)
+		return
+	}
+
 	// if function name is not empty, define it in the env
 	if funcExpr.Name != "" {
-		runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		err := runInfo.env.DefineValue(funcExpr.Name, runInfo.rv)
+		if err != nil {
+			runInfo.err = newError(funcExpr, err)
+			runInfo.rv = nilValue
+		}
 	}
 }
 
+// getMethod returns the method defined by the script for the value, with the receiver bound.
+// Like Go, methods of the element type can be called on pointers and methods of the pointer type on values.
+func (runInfo *runInfoStruct) getMethod(value reflect.Value, name string) (reflect.Value, bool) {
+	if !value.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	valueType := value.Type()
+	method, found := runInfo.env.Method(valueType, name)
+	if found {
+		if valueType.Kind() != reflect.Ptr {
+			// method has value receiver, so gets a copy
+			value = copyValue(value)
+		}
+		return bindMethod(method, value), true
+	}
+
+	if valueType.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		method, found = runInfo.env.Method(valueType.Elem(), name)
+		if found {
+			return bindMethod(method, copyValue(value.Elem())), true
+		}
+		return reflect.Value{}, false
+	}
+
+	method, found = runInfo.env.Method(reflect.PtrTo(valueType), name)
+	if !found {
+		return reflect.Value{}, false
+	}
+	if value.CanAddr() {
+		return bindMethod(method, value.Addr()), true
+	}
+	ptr := reflect.New(valueType)
+	ptr.Elem().Set(value)
+	return bindMethod(method, ptr), true
+}
+
+// copyValue returns an addressable copy of value
+func copyValue(value reflect.Value) reflect.Value {
+	valueCopy := reflect.New(value.Type()).Elem()
+	valueCopy.Set(value)
+	return valueCopy
+}
+
+// bindMethod creates a runVMFunction that calls the method with receiver as the first argument
+func bindMethod(method reflect.Value, receiver reflect.Value) reflect.Value {
+	methodType := method.Type()
+
+	// same as the method type without the receiver
+	inTypes := make([]reflect.Type, 0, methodType.NumIn()-1)
+	inTypes = append(inTypes, contextType)
+	for i := 2; i < methodType.NumIn(); i++ {
+		inTypes = append(inTypes, methodType.In(i))
+	}
+	funcType := reflect.FuncOf(inTypes, []reflect.Type{reflectValueType, reflectValueType}, methodType.IsVariadic())
+
+	return reflect.MakeFunc(funcType, func(in []reflect.Value) []reflect.Value {
+		args := make([]reflect.Value, 0, len(in)+1)
+		args = append(args, in[0], reflect.ValueOf(receiver))
+		args = append(args, in[1:]...)
+		if methodType.IsVariadic() {
+			return method.CallSlice(args)
+		}
+		return method.Call(args)
+	})
+}
+
 // anonCallExpr handles ast.AnonCallExpr which calls a function anonymously
 func (runInfo *runInfoStruct) anonCallExpr() {
 	anonCallExpr := runInfo.expr.(*ast.AnonCallExpr)
@@ -91,13 +195,18 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 	if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
 		runInfo.rv = runInfo.rv.Elem()
 	}
+	if anonCallExpr.Optional && isNil(runInfo.rv) {
+		// optional chain, nil instead of an error and the arguments are not evaluated
+		runInfo.rv = nilValue
+		return
+	}
 	if runInfo.rv.Kind() != reflect.Func {
 		runInfo.err = newStringError(anonCallExpr, "cannot call type "+runInfo.rv.Kind().String())
 		runInfo.rv = nilValue
 		return
 	}
 
-	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
+	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go, Defer: anonCallExpr.Defer}
 	runInfo.invokeExpr()
 }
 
@@ -127,6 +236,18 @@ func (runInfo *runInfoStruct) callExpr() {
 		return
 	}
 
+	if hasSpreadExpr(callExpr.SubExprs) {
+		// expand the spreads into the arguments, the call is then no longer variadic
+		subExprs := runInfo.expandSpreadExprs(callExpr.SubExprs)
+		if runInfo.err != nil {
+			return
+		}
+		spreadCallExpr := *callExpr
+		spreadCallExpr.SubExprs = subExprs
+		spreadCallExpr.VarArg = false
+		callExpr = &spreadCallExpr
+	}
+
 	var rvs []reflect.Value
 	var args []reflect.Value
 	var useCallSlice bool
@@ -139,28 +260,41 @@ func (runInfo *runInfoStruct) callExpr() {
 		return
 	}
 
+	if callExpr.Defer {
+		// function and arguments are evaluated now, the call is done when the function returns
+		runInfo.deferredCalls = append(runInfo.deferredCalls, deferredCall{f: f, args: args, useCallSlice: useCallSlice, isRunVMFunction: isRunVMFunction})
+		runInfo.rv = nilValue
+		return
+	}
+
 	// capture panics if not in debug mode
 	defer func() {
 		if !runInfo.options.Debug {
 			if recoverResult := recover(); recoverResult != nil {
-				runInfo.err = fmt.Errorf("%v", recoverResult)
+				if err, ok := recoverResult.(error); ok {
+					runInfo.err = err
+				} else {
+					runInfo.err = fmt.Errorf("%v", recoverResult)
+				}
 				runInfo.rv = nilValue
 			}
 		}
 	}()
 
+	if callExpr.Go {
+		if isRunVMFunction {
+			// the goroutine counts its calls in its own callDepth
+			args[0] = reflect.ValueOf(withCallDepth(runInfo.ctx, runInfo.options))
+		}
+		runInfo.err = runInfo.goroutines.goCall(callExpr, f, args, useCallSlice, isRunVMFunction)
+		runInfo.rv = nilValue
+		return
+	}
+
 	// useCallSlice lets us know to use CallSlice instead of Call because of the format of the args
 	if useCallSlice {
-		if callExpr.Go {
-			go f.CallSlice(args)
-			return
-		}
 		rvs = f.CallSlice(args)
 	} else {
-		if callExpr.Go {
-			go f.Call(args)
-			return
-		}
 		rvs = f.Call(args)
 	}
 
@@ -183,6 +317,93 @@ func (runInfo *runInfoStruct) callExpr() {
 	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true)
 }
 
+// runDeferredCalls runs the calls added by defer statements in last in first out order.
+// An error from a deferred call replaces the return of the function.
+// Like the panics of Go, it is combined with the error the function already had, so neither is lost.
+// When the run has been interrupted, the deferred calls keep the limits of the run
+// and are interrupted again after Options.InterruptedDeferTimeout.
+func (runInfo *runInfoStruct) runDeferredCalls() {
+	if len(runInfo.deferredCalls) == 0 {
+		return
+	}
+
+	rv := runInfo.rv
+	ctx := runInfo.ctx
+	select {
+	case <-ctx.Done():
+		// context is done, use one that is not yet done so deferred VM functions can still run
+		timeout := runInfo.options.InterruptedDeferTimeout
+		if timeout == 0 {
+			timeout = DefaultInterruptedDeferTimeout
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+	default:
+	}
+
+	for i := len(runInfo.deferredCalls) - 1; i >= 0; i-- {
+		err := runInfo.deferredCalls[i].call(ctx, runInfo.options.Debug)
+		if err == nil || (errors.Is(err, ErrInterrupt) && errors.Is(runInfo.err, ErrInterrupt)) {
+			continue
+		}
+		runInfo.addDeferredError(err)
+		rv = nilValue
+	}
+	runInfo.deferredCalls = nil
+	runInfo.rv = rv
+}
+
+// addDeferredError adds the error of a deferred call to runInfo.err.
+// A VM error keeps its position and the deferred error is attached to a copy of it.
+// ErrInterrupt is kept as is, so it can still be compared, and errors of deferred calls after it are not returned.
+func (runInfo *runInfoStruct) addDeferredError(err error) {
+	switch runInfo.err {
+	case nil, ErrReturn:
+		runInfo.err = err
+		return
+	case ErrInterrupt:
+		return
+	}
+	vmErr, ok := runInfo.err.(*Error)
+	if ok {
+		errCopy := *vmErr
+		vmErr = &errCopy
+	} else {
+		vmErr = newError(runInfo.stmt, runInfo.err).(*Error)
+	}
+	vmErr.deferred = append(vmErr.deferred[:len(vmErr.deferred):len(vmErr.deferred)], err)
+	runInfo.err = vmErr
+}
+
+// call calls the deferred function with the arguments saved by the defer statement
+func (deferredCall *deferredCall) call(ctx context.Context, debug bool) (err error) {
+	// capture panics if not in debug mode
+	defer func() {
+		if !debug {
+			if recoverResult := recover(); recoverResult != nil {
+				err = fmt.Errorf("%v", recoverResult)
+			}
+		}
+	}()
+
+	args := deferredCall.args
+	if deferredCall.isRunVMFunction {
+		// for runVMFunction first arg is always context
+		args = append([]reflect.Value{reflect.ValueOf(ctx)}, args[1:]...)
+	}
+
+	var rvs []reflect.Value
+	if deferredCall.useCallSlice {
+		rvs = deferredCall.f.CallSlice(args)
+	} else {
+		rvs = deferredCall.f.Call(args)
+	}
+
+	_, err = processCallReturnValues(rvs, deferredCall.isRunVMFunction, true)
+	return err
+}
+
 // checkIfRunVMFunction checking the number and types of the reflect.Type.
 // If it matches the types for a runVMFunction this will return true, otherwise false
 func checkIfRunVMFunction(rt reflect.Type) bool {
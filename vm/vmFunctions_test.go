@@ -2,7 +2,10 @@ package vm
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"sync"
 	"testing"
@@ -378,6 +381,47 @@ func TestFunctions(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestArrowFunctions(t *testing.T) {
+	tests := []Test{
+		{Script: `a = () => 1; a()`, RunOutput: int64(1)},
+		{Script: `a = b => b + 1; a(1)`, RunOutput: int64(2)},
+		{Script: `a = (b) => b + 1; a(1)`, RunOutput: int64(2)},
+		{Script: `a = (b, c) => b < c; a(1, 2)`, RunOutput: true},
+		{Script: `a = (b, c, d) => b + c + d; a(1, 2, 3)`, RunOutput: int64(6)},
+		{Script: `a = (b...) => b; a(1, 2)`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a = (b, c...) => c; a(1, 2, 3)`, RunOutput: []interface{}{int64(2), int64(3)}},
+		{Script: `a = b => { c = b * 2; return c + 1 }; a(1)`, RunOutput: int64(3)},
+		{Script: `a = (b, c) => {
+	return b + c
+}; a(1, 2)`, RunOutput: int64(3)},
+		{Script: `a = b => c => b + c; a(1)(2)`, RunOutput: int64(3)},
+		{Script: `(b => b * 2)(2)`, RunOutput: int64(4)},
+		{Script: `a = b => b ? 1 : 2; a(false)`, RunOutput: int64(2)},
+		{Script: `a = b => ({"c": b}); a(1)`, RunOutput: map[interface{}]interface{}{"c": int64(1)}},
+		{Script: `a = b => {"c": b}; a(1)`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = () => {}; a()`, RunOutput: nil},
+		{Script: `a = {"b": 1}; c = d => a; c(1)`, RunOutput: map[interface{}]interface{}{"b": int64(1)}},
+		{Script: `a = 1; b = () => a; a = 2; b()`, RunOutput: int64(2)},
+		{Script: `a = b => b; a()`, RunError: fmt.Errorf("function wants 1 arguments but received 0")},
+		{Script: `a = b => c; a(1)`, RunError: fmt.Errorf("undefined symbol 'c'")},
+		{Script: `(1) => 2`, ParseError: fmt.Errorf("syntax error: unexpected =>")},
+		{Script: `(a + b) => 2`, ParseError: fmt.Errorf("syntax error: unexpected =>")},
+		{Script: `a, b => 2`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a(b => b * 2)`, Input: map[string]interface{}{"a": func(b func(int64) int64) int64 { return b(2) }}, RunOutput: int64(4)},
+		{Script: `a((b, c) => b < c)`, Input: map[string]interface{}{"a": func(b func(int, int) bool) bool { return b(1, 2) }}, RunOutput: true},
+		{Script: `b = [3, 1, 2]; a(b, (i, j) => b[i] < b[j]); b`, Input: map[string]interface{}{"a": func(x interface{}, less func(i, j int) bool) {
+			s := x.([]interface{})
+			for i := 1; i < len(s); i++ {
+				for j := i; j > 0 && less(j, j-1); j-- {
+					s[j], s[j-1] = s[j-1], s[j]
+				}
+			}
+		}}, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPointerFunctions(t *testing.T) {
 	testFunctionPointer := func(b interface{}) string {
 		rv := reflect.ValueOf(b)
@@ -473,6 +517,29 @@ func TestVariadicFunctions(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestSpreadArguments(t *testing.T) {
+	tests := []Test{
+		{Script: `func a(b, c, d) { return [b, c, d] }; a([1, 2]..., 3)`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `func a(b, c, d) { return [b, c, d] }; a(1, [2]..., [3]...)`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `func a(b, c, d) { return [b, c, d] }; a([]..., 1, [2, 3]...)`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `func a(b, c, d) { return [b, c, d] }; a(nil..., 1, 2, 3)`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `func a(b, c...) { return c }; a([1, 2]..., 3, [4]...)`, RunOutput: []interface{}{int64(2), int64(3), int64(4)}},
+		{Script: `func a(b, c) { return c }; a([1, 2]..., 3)`, RunError: fmt.Errorf("function wants 2 arguments but received 3")},
+		{Script: `func a(b, c) { return c }; a(true..., 2)`, RunError: fmt.Errorf("cannot spread type bool")},
+		{Script: `func a(b, c) { return c }; a(d..., 2)`, RunError: fmt.Errorf("undefined symbol 'd'")},
+		{Script: `a = [1, 2]; b = a...`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = [1, 2]; return a...`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = [1, 2]; switch 1 { case a...: }`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a([1]..., 2)`, Input: map[string]interface{}{"a": func(b int32, c int64, d ...string) string { return fmt.Sprint(b, c, d) }}, RunOutput: "1 2 []"},
+		{Script: `a([1, 2]..., "c", ["d", "e"]...)`, Input: map[string]interface{}{"a": func(b int32, c int64, d ...string) string { return fmt.Sprint(b, c, d) }}, RunOutput: "1 2 [c d e]"},
+		{Script: `a([1]..., ["b"]...)`, Input: map[string]interface{}{"a": func(b int32, c int64, d ...string) string { return fmt.Sprint(b, c, d) }}, RunError: fmt.Errorf("function wants argument type int64 but received type string")},
+		{Script: `b = []; go a(b..., 1, [2]...); c`, Input: map[string]interface{}{"a": func(b, c int64) {}}, RunError: fmt.Errorf("undefined symbol 'c'")},
+		{Script: `func f() { defer a(b..., 1); b = [2]; return b }; f()`, Input: map[string]interface{}{"a": func(b int64) {}, "b": []interface{}{}}, RunOutput: []interface{}{int64(2)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestFunctionsInArraysAndMaps(t *testing.T) {
 	tests := []Test{
 		{Script: `a = [func () { return nil }]; a[0]()`, RunOutput: nil},
@@ -779,6 +846,131 @@ func TestCallFunctionWithVararg(t *testing.T) {
 	}
 }
 
+func TestDefer(t *testing.T) {
+	tests := []Test{
+		{Script: `defer 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `defer a()`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `defer a(b)`, Input: map[string]interface{}{"a": func(b int64) {}}, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `a = 1; defer a()`, RunError: fmt.Errorf("cannot call type int64"), Output: map[string]interface{}{"a": int64(1)}},
+
+		{Script: `a = []; defer func() { a += 1 }(); a += 2`, RunOutput: []interface{}{int64(2)}, Output: map[string]interface{}{"a": []interface{}{int64(2), int64(1)}}},
+		{Script: `a = []; func b() { defer func() { a += 1 }(); defer func() { a += 2 }(); a += 3 }; b(); a`, RunOutput: []interface{}{int64(3), int64(2), int64(1)}},
+		{Script: `a = []; func b() { a += 1 }; func c() { defer b(); a += 2 }; c(); a`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `a = []; func b() { for i = 0; i < 3; i++ { defer func(j) { a += j }(i) } }; b(); a`, RunOutput: []interface{}{int64(2), int64(1), int64(0)}},
+		{Script: `a = []; func b() { c = 1; defer func(d) { a += d }(c); c = 2 }; b(); a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = []; func b(c...) { a += c }; func d() { defer b([1, 2]...) }; d(); a`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a = []; func b() { if true { defer func() { a += 1 }() }; a += 2 }; b(); a`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `a = []; func b() { defer c(1) }; b(); a`, Input: map[string]interface{}{"c": func(b int64) int64 { return b + 1 }}, RunOutput: []interface{}{}},
+
+		{Script: `func a() { defer func() { return 2 }(); return 1 }; a()`, RunOutput: int64(1)},
+		{Script: `func a() { defer func() { throw "b" }(); return 1 }; a()`, RunError: fmt.Errorf("b")},
+		{Script: `func a() { defer func() { throw "b" }(); throw "c" }; a()`, RunError: fmt.Errorf("c\nb")},
+		{Script: `a = []; func b() { defer func() { a += 1 }(); throw "c" }; try { b() } catch { }; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = []; func b() { defer func() { a += 1 }(); defer func() { throw "c" }() }; try { b() } catch { }; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = []; func b() { defer func() { a += 1 }(); c = 1++ }; try { b() } catch { }; a`, RunOutput: []interface{}{int64(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `a = []; func b() { defer func() { a += 1 }(); c() }; try { b() } catch { }; a`, Input: map[string]interface{}{"c": func() { panic("c") }}, RunOutput: []interface{}{int64(1)}},
+		{Script: `func a() { defer b(); return 1 }; a()`, Input: map[string]interface{}{"b": func() { panic("b") }}, RunError: fmt.Errorf("b")},
+	}
+	runTests(t, tests, nil, &Options{Debug: false})
+}
+
+func TestDeferWithContext(t *testing.T) {
+	var deferred bool
+	e := env.NewEnv()
+	err := e.Define("setDeferred", func() { deferred = true })
+	if err != nil {
+		t.Fatalf("Define error: %v", err)
+	}
+	waitChan := make(chan struct{}, 1)
+	err = e.Define("waitChan", waitChan)
+	if err != nil {
+		t.Fatalf("Define error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-waitChan
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+
+	script := `
+func a() {
+	defer func() { b = 1; setDeferred() }()
+	close(waitChan)
+	for { }
+}
+a()
+`
+	_, err = ExecuteContext(ctx, e, nil, script)
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("execute error - received %#v - expected: %#v", err, ErrInterrupt)
+	}
+	if !deferred {
+		t.Errorf("deferred function was not called")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = ExecuteContext(ctx, env.NewEnv(), &Options{InterruptedDeferTimeout: 10 * time.Millisecond}, `func a() { defer func() { for { } }(); for { } }; a()`)
+	if !errors.Is(err, ErrInterrupt) {
+		t.Errorf("execute error - received %#v - expected: %#v", err, ErrInterrupt)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = ExecuteContext(ctx, env.NewEnv(), nil, `func a() { defer func() { throw "b" }(); for { } }; a()`)
+	if !errors.Is(err, ErrInterrupt) || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("execute error - received %#v - expected: %#v", err, ErrInterrupt)
+	}
+
+	// the error of a deferred call is attached to the VM error the function already had
+	e = env.NewEnv()
+	err = e.Define("b", io.EOF)
+	if err != nil {
+		t.Fatalf("Define error: %v", err)
+	}
+	_, err = Execute(e, nil, `func a() { defer func() { throw b }(); throw "c" }; a()`)
+	var vmErr *Error
+	if !errors.As(err, &vmErr) || !errors.Is(err, io.EOF) || err.Error() != "c\nEOF" {
+		t.Errorf("execute error - received %#v - expected: %#v", err, "c\nEOF")
+	}
+}
+
+func TestMethods(t *testing.T) {
+	tests := []Test{
+		{Script: `func (a b) c() { }`, RunError: fmt.Errorf("undefined type 'b'")},
+		{Script: `a = 1; a.b()`, RunError: fmt.Errorf("type int64 does not support member operation")},
+		{Script: `func (a int64) b() { }; a = "c"; a.b()`, RunError: fmt.Errorf("type string does not support member operation")},
+		{Script: `if true { func (a int64) b() { return a } }; a = 1; a.b()`, RunError: fmt.Errorf("type int64 does not support member operation")},
+
+		{Script: `func (a int64) b() { return a * 2 }; a = 2; a.b()`, RunOutput: int64(4)},
+		{Script: `func (a int64) b(c, d) { return a + c + d }; a = 2; a.b(3, 4)`, RunOutput: int64(9)},
+		{Script: `func (a int64) b(c...) { return len(c) }; a = 2; a.b(3, 4)`, RunOutput: int64(2)},
+		{Script: `func (a string) b() { return a + "c" }; a = "a"; a.b()`, RunOutput: "ac"},
+		{Script: `func (a int64) b() { return a * 2 }; a = 2; c = a.b; a = 3; c()`, RunOutput: int64(4)},
+		{Script: `func (a int64) b() { return a * 2 }; func c() { d = 3; return d.b() }; c()`, RunOutput: int64(6)},
+		{Script: `func (a int64) b() { return 1 }; func (a int64) b() { return 2 }; a = 2; a.b()`, RunOutput: int64(2)},
+
+		{Script: `make(type a, struct { A int64 }); func (b a) c() { return b.A }; b = make(a); b.A = 1; b.c()`, RunOutput: int64(1)},
+		{Script: `make(type a, struct { A int64 }); func (b a) c() { b.A = 2 }; b = make(a); b.A = 1; b.c(); b.A`, RunOutput: int64(1)},
+		{Script: `make(type a, struct { A int64 }); func (b *a) c() { b.A = 2 }; b = make(a); b.A = 1; b.c(); b.A`, RunOutput: int64(2)},
+		{Script: `make(type a, struct { A int64 }); func (b *a) c() { b.A = 2 }; b = new(a); b.A = 1; b.c(); b.A`, RunOutput: int64(2)},
+		{Script: `make(type a, struct { A int64 }); func (b a) c() { return b.A }; b = new(a); b.A = 1; b.c()`, RunOutput: int64(1)},
+		{Script: `make(type a, struct { A int64 }); func (b a) c() { return b.A }; b = make([]a, 1); b[0].c()`, RunOutput: int64(0)},
+
+		{Script: `a.b()`, Input: map[string]interface{}{"a": time.Duration(1)}, Types: map[string]interface{}{"duration": time.Duration(1)}, RunError: fmt.Errorf("type int64 does not support member operation"), Output: map[string]interface{}{"a": time.Duration(1)}},
+		{Script: `func (a duration) b() { return a * 2 }; a.b()`, Input: map[string]interface{}{"a": time.Duration(1)}, Types: map[string]interface{}{"duration": time.Duration(1)}, RunOutput: int64(2), Output: map[string]interface{}{"a": time.Duration(1)}},
+		{Script: `func (a duration) String() { return "b" }; a.String()`, Input: map[string]interface{}{"a": time.Duration(1)}, Types: map[string]interface{}{"duration": time.Duration(1)}, RunOutput: "b", Output: map[string]interface{}{"a": time.Duration(1)}},
+		{Script: `a.String()`, Input: map[string]interface{}{"a": time.Duration(1)}, Types: map[string]interface{}{"duration": time.Duration(1)}, RunOutput: "1ns", Output: map[string]interface{}{"a": time.Duration(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestGoFunctionConcurrency(t *testing.T) {
 	tests := []Test{
 		{Script: `
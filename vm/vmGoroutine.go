@@ -0,0 +1,145 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gbl08ma/anko/ast"
+)
+
+// GoroutineMode is what RunContext does with the goroutines started by go statements
+// that are still running when the script returns
+type GoroutineMode int
+
+const (
+	// GoroutinesDetach leaves the goroutines running after RunContext returns
+	GoroutinesDetach GoroutineMode = iota
+	// GoroutinesWait makes RunContext wait for the goroutines to return
+	GoroutinesWait
+	// GoroutinesCancel makes RunContext interrupt the goroutines and wait for them to return.
+	// Go functions started by a go statement are not interrupted, RunContext waits for them to return.
+	GoroutinesCancel
+)
+
+type (
+	// goroutineGroup tracks the goroutines started by the go statements of a run,
+	// shared by the script functions and goroutines of the run
+	goroutineGroup struct {
+		debug         bool
+		maxGoroutines int64
+		running       int64
+		errorHandler  func(error)
+		waitGroup     sync.WaitGroup
+
+		mutex sync.Mutex
+		err   error // the first error of the goroutines
+	}
+
+	// goroutineGroupKey is the context key of the goroutineGroup of a run
+	goroutineGroupKey struct{}
+)
+
+// ErrGoroutineLimit when a go statement would run more goroutines at once than Options.MaxGoroutines
+var ErrGoroutineLimit = errors.New("goroutine limit exceeded")
+
+// newGoroutineGroup returns the goroutineGroup for a run with the options
+func newGoroutineGroup(options *Options) *goroutineGroup {
+	return &goroutineGroup{
+		debug:         options.Debug,
+		maxGoroutines: int64(options.MaxGoroutines),
+		errorHandler:  options.GoroutineErrorHandler,
+	}
+}
+
+// goroutineGroupFromContext returns the goroutineGroup of the run of the context, or nil if it is not from a run
+func goroutineGroupFromContext(ctx context.Context) *goroutineGroup {
+	group, _ := ctx.Value(goroutineGroupKey{}).(*goroutineGroup)
+	return group
+}
+
+// goCall calls the function in a new goroutine of the group.
+// The error of the call, including panics, is passed to the error handler and kept for wait.
+// A nil group calls the function in an untracked goroutine, recovering panics.
+func (group *goroutineGroup) goCall(pos ast.Pos, f reflect.Value, args []reflect.Value, useCallSlice bool, isRunVMFunction bool) error {
+	if group == nil {
+		group = &goroutineGroup{}
+	}
+	if group.maxGoroutines > 0 && atomic.AddInt64(&group.running, 1) > group.maxGoroutines {
+		atomic.AddInt64(&group.running, -1)
+		return newError(pos, ErrGoroutineLimit)
+	}
+
+	group.waitGroup.Add(1)
+	go func() {
+		defer group.waitGroup.Done()
+		if group.maxGoroutines > 0 {
+			defer atomic.AddInt64(&group.running, -1)
+		}
+		err := group.call(f, args, useCallSlice, isRunVMFunction)
+		if err == nil || errors.Is(err, ErrInterrupt) {
+			return
+		}
+		if _, ok := err.(*Error); !ok {
+			err = newError(pos, err)
+		}
+		group.addError(err)
+	}()
+	return nil
+}
+
+// call calls the function, returning the error of a script function, the last return value of a Go function if it is an error,
+// or the panic of the call
+func (group *goroutineGroup) call(f reflect.Value, args []reflect.Value, useCallSlice bool, isRunVMFunction bool) (err error) {
+	// capture panics if not in debug mode
+	defer func() {
+		if !group.debug {
+			if recoverResult := recover(); recoverResult != nil {
+				if recoverErr, ok := recoverResult.(error); ok {
+					err = recoverErr
+				} else {
+					err = fmt.Errorf("%v", recoverResult)
+				}
+			}
+		}
+	}()
+
+	var rvs []reflect.Value
+	if useCallSlice {
+		rvs = f.CallSlice(args)
+	} else {
+		rvs = f.Call(args)
+	}
+
+	if isRunVMFunction {
+		_, err = processCallReturnValues(rvs, true, false)
+		return err
+	}
+	if len(rvs) > 0 && rvs[len(rvs)-1].Type() == errorType && !rvs[len(rvs)-1].IsNil() {
+		return rvs[len(rvs)-1].Interface().(error)
+	}
+	return nil
+}
+
+// addError passes the error to the error handler and keeps it if it is the first
+func (group *goroutineGroup) addError(err error) {
+	if group.errorHandler != nil {
+		group.errorHandler(err)
+	}
+	group.mutex.Lock()
+	if group.err == nil {
+		group.err = err
+	}
+	group.mutex.Unlock()
+}
+
+// wait waits for the goroutines to return, returning the first of their errors
+func (group *goroutineGroup) wait() error {
+	group.waitGroup.Wait()
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+	return group.err
+}
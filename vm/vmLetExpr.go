@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	"github.com/gbl08ma/anko/ast"
+	"github.com/gbl08ma/anko/env"
 )
 
 func (runInfo *runInfoStruct) invokeLetExpr() {
@@ -11,7 +12,14 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 	// IdentExpr
 	case *ast.IdentExpr:
-		if runInfo.env.SetValue(expr.Lit, runInfo.rv) != nil {
+		err := runInfo.env.SetValue(expr.Lit, runInfo.rv)
+		if err != nil {
+			// in strict mode assignment can not define a new variable
+			if _, ok := err.(*env.ConstantError); ok || runInfo.options.Strict {
+				runInfo.err = newError(expr, err)
+				runInfo.rv = nilValue
+				return
+			}
 			runInfo.err = nil
 			runInfo.env.DefineValue(expr.Lit, runInfo.rv)
 		}
@@ -130,6 +138,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 					runInfo.rv = nilValue
 					return
 				}
+				runInfo.err = runInfo.checkContainerLength(expr, int64(index+1), typeSize(item.Type().Elem()))
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
 				item = reflect.Append(item, value)
 				runInfo.rv = item
 				runInfo.expr = expr.Item
@@ -176,6 +189,15 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
+			if !item.MapIndex(runInfo.rv).IsValid() {
+				// new key
+				runInfo.err = runInfo.checkContainerLength(expr, int64(item.Len()+1), typeSize(item.Type().Key())+typeSize(item.Type().Elem()))
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
+			}
+
 			if item.IsNil() {
 				// make new map
 				item = reflect.MakeMap(item.Type())
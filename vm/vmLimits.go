@@ -0,0 +1,197 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"github.com/gbl08ma/anko/ast"
+)
+
+type (
+	// runLimits has the limits of a run and counts the steps run and bytes allocated,
+	// shared by the script functions and goroutines of the run
+	runLimits struct {
+		countSteps         bool
+		steps              int64
+		maxSteps           int64
+		allocBytes         int64
+		maxAllocBytes      int64
+		maxContainerLength int64
+		maxStringLength    int64
+	}
+
+	// runLimitsKey is the context key of the runLimits of a run
+	runLimitsKey struct{}
+
+	// callDepth is the depth of the script function call running and the limit of the depth.
+	// It is put in the context once by RunContext, a go statement or a call from Go with a context without one,
+	// and is changed by the calls run by the goroutine, so it is not shared between goroutines
+	callDepth struct {
+		depth    int
+		maxDepth int
+	}
+
+	// callDepthKey is the context key of the *callDepth of a goroutine of a run
+	callDepthKey struct{}
+)
+
+// DefaultMaxCallDepth is the maximum depth of nested script function calls when Options.MaxCallDepth is zero.
+// It is well below the depth at which the Go runtime aborts with a stack overflow.
+const DefaultMaxCallDepth = 10000
+
+// DefaultInterruptedDeferTimeout is how long deferred calls can run after the run is interrupted
+// when Options.InterruptedDeferTimeout is zero
+const DefaultInterruptedDeferTimeout = time.Second
+
+var (
+	// ErrStepLimit when execution has run more steps than Options.MaxSteps.
+	// It is returned wrapped in an Error with the position of the step, use errors.Is to check for it
+	ErrStepLimit = errors.New("step limit exceeded")
+	// ErrContainerLengthLimit when a slice or map would be longer than Options.MaxContainerLength
+	ErrContainerLengthLimit = errors.New("container length limit exceeded")
+	// ErrStringLengthLimit when a string would be longer than Options.MaxStringLength
+	ErrStringLengthLimit = errors.New("string length limit exceeded")
+	// ErrAllocLimit when execution has allocated more bytes than Options.MaxAllocBytes
+	ErrAllocLimit = errors.New("allocation limit exceeded")
+	// ErrStackOverflow when script function calls are nested deeper than Options.MaxCallDepth
+	ErrStackOverflow = errors.New("stack overflow")
+
+	interfaceSize = int64(interfaceType.Size())
+)
+
+// newRunLimits returns the runLimits for the options, nil if the options have no limits
+func newRunLimits(options *Options) *runLimits {
+	if options.MaxSteps < 1 && options.Steps == nil && options.MaxAllocBytes < 1 && options.MaxContainerLength < 1 && options.MaxStringLength < 1 {
+		return nil
+	}
+	return &runLimits{
+		countSteps:         options.MaxSteps > 0 || options.Steps != nil,
+		maxSteps:           options.MaxSteps,
+		maxAllocBytes:      options.MaxAllocBytes,
+		maxContainerLength: options.MaxContainerLength,
+		maxStringLength:    options.MaxStringLength,
+	}
+}
+
+// runLimitsFromContext returns the runLimits of the run of the context, or nil if the run has no limits
+func runLimitsFromContext(ctx context.Context) *runLimits {
+	limits, _ := ctx.Value(runLimitsKey{}).(*runLimits)
+	return limits
+}
+
+// withCallDepth returns a child of the context with a new callDepth that has the limit of the options,
+// starting at the depth of the calls of the context
+func withCallDepth(ctx context.Context, options *Options) context.Context {
+	depth := &callDepth{maxDepth: options.MaxCallDepth}
+	if parent, ok := ctx.Value(callDepthKey{}).(*callDepth); ok {
+		depth.depth = parent.depth
+	}
+	if depth.maxDepth == 0 {
+		depth.maxDepth = DefaultMaxCallDepth
+	}
+	return context.WithValue(ctx, callDepthKey{}, depth)
+}
+
+// enterCall sets runInfo.callDepth to one deeper than the caller, the depth of the callDepth of the context,
+// or returns ErrStackOverflow if that is deeper than the limit.
+// The depth of the returned callDepth has to be set back to the depth of the caller when the call returns.
+func (runInfo *runInfoStruct) enterCall() (*callDepth, error) {
+	depth, ok := runInfo.ctx.Value(callDepthKey{}).(*callDepth)
+	if !ok {
+		// called from Go, so the limit of the run that defined the function is used
+		runInfo.ctx = withCallDepth(runInfo.ctx, runInfo.options)
+		depth = runInfo.ctx.Value(callDepthKey{}).(*callDepth)
+	}
+	if depth.maxDepth > 0 && depth.depth >= depth.maxDepth {
+		return nil, ErrStackOverflow
+	}
+	runInfo.callDepth = depth.depth + 1
+	depth.depth = runInfo.callDepth
+	return depth, nil
+}
+
+// isRunLimitError returns true if the error is a limit of the whole run being exceeded,
+// which try statements do not catch
+func isRunLimitError(err error) bool {
+	return errors.Is(err, ErrStepLimit) || errors.Is(err, ErrAllocLimit)
+}
+
+// step counts one step, returning false when there are no steps left
+func (limits *runLimits) step() bool {
+	if !limits.countSteps {
+		return true
+	}
+	steps := atomic.AddInt64(&limits.steps, 1)
+	return limits.maxSteps < 1 || steps <= limits.maxSteps
+}
+
+// stepsUsed returns the number of steps run, which is at most maxSteps
+func (limits *runLimits) stepsUsed() int64 {
+	if limits == nil {
+		return 0
+	}
+	steps := atomic.LoadInt64(&limits.steps)
+	if limits.maxSteps > 0 && steps > limits.maxSteps {
+		return limits.maxSteps
+	}
+	return steps
+}
+
+// checkContainerLength returns an error if a slice or map of the length would exceed the limits.
+// The bytes of each element are added to the bytes allocated.
+func (runInfo *runInfoStruct) checkContainerLength(pos ast.Pos, length int64, elementSize int64) error {
+	if runInfo.limits == nil {
+		return nil
+	}
+	if runInfo.limits.maxContainerLength > 0 && length > runInfo.limits.maxContainerLength {
+		return newError(pos, ErrContainerLengthLimit)
+	}
+	return runInfo.allocate(pos, multiplyLengths(length, elementSize))
+}
+
+// checkStringLength returns an error if a string of the length would exceed the limits.
+// The length is added to the bytes allocated.
+func (runInfo *runInfoStruct) checkStringLength(pos ast.Pos, length int64) error {
+	if runInfo.limits == nil {
+		return nil
+	}
+	if runInfo.limits.maxStringLength > 0 && length > runInfo.limits.maxStringLength {
+		return newError(pos, ErrStringLengthLimit)
+	}
+	return runInfo.allocate(pos, length)
+}
+
+// allocate adds the bytes to the bytes allocated, returning an error if that exceeds the limit
+func (runInfo *runInfoStruct) allocate(pos ast.Pos, bytes int64) error {
+	if runInfo.limits == nil || runInfo.limits.maxAllocBytes < 1 {
+		return nil
+	}
+	if bytes < 0 || bytes > runInfo.limits.maxAllocBytes {
+		// also avoids overflow of the total
+		return newError(pos, ErrAllocLimit)
+	}
+	if atomic.AddInt64(&runInfo.limits.allocBytes, bytes) > runInfo.limits.maxAllocBytes {
+		return newError(pos, ErrAllocLimit)
+	}
+	return nil
+}
+
+// multiplyLengths returns a times b, or the maximum int64 if that overflows
+func multiplyLengths(a int64, b int64) int64 {
+	if a > 0 && b > 0 && a > math.MaxInt64/b {
+		return math.MaxInt64
+	}
+	return a * b
+}
+
+// typeSize returns the approximate bytes of a value of the type
+func typeSize(t reflect.Type) int64 {
+	if t == nil {
+		return interfaceSize
+	}
+	return int64(t.Size())
+}
@@ -0,0 +1,237 @@
+package vm
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gbl08ma/anko/ast"
+	"github.com/gbl08ma/anko/env"
+	"github.com/gbl08ma/anko/parser"
+)
+
+type (
+	// ModuleLoader loads the script modules imported by path, like import "./lib/util.ank".
+	// Each module is run once in its own Env and cached by its canonical path,
+	// every import of the module returns that Env.
+	ModuleLoader struct {
+		// Paths are the directories searched for modules, the current directory if empty.
+		// Paths starting with ./ or ../ imported by a module are relative to the directory of that module instead.
+		// Modules outside of the Paths, including absolute paths, are not allowed.
+		Paths []string
+		// NewEnv returns the Env to run a module in, given the global scope of the importing Env.
+		// If nil the module is run in a child of a copy of the global scope,
+		// so it has the same builtins but its variables do not change the global scope.
+		NewEnv func(global *env.Env) *env.Env
+
+		mutex   sync.Mutex
+		modules map[string]*module
+	}
+
+	// module is a loaded script module
+	module struct {
+		env  *env.Env
+		err  error
+		done chan struct{} // closed once the module has run
+	}
+
+	// moduleFile is the script module being run, linked to the module that imported it
+	moduleFile struct {
+		filename string
+		importer *moduleFile
+	}
+)
+
+// NewModuleLoader returns a ModuleLoader that searches for modules in paths.
+func NewModuleLoader(paths ...string) *ModuleLoader {
+	return &ModuleLoader{Paths: paths}
+}
+
+// isModulePath returns true if the import name is the path of a script module instead of the name of a package
+func isModulePath(name string) bool {
+	return strings.HasSuffix(name, ".ank") || strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../") || filepath.IsAbs(name)
+}
+
+// resolve returns the canonical path of the module imported by the importer, which is nil for the main script.
+// The module has to be in one of the Paths, both before and after following symbolic links.
+func (loader *ModuleLoader) resolve(name string, importer *moduleFile) (string, error) {
+	if filepath.IsAbs(name) {
+		return "", errors.New("module not allowed: " + name)
+	}
+
+	paths := loader.Paths
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	// the directories as given and with symbolic links followed, since the filename of an importer has them followed
+	dirs := make([]string, 0, 2*len(paths))
+	for _, path := range paths {
+		dir, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		dirs = append(dirs, dir)
+		if dir, err = filepath.EvalSymlinks(dir); err == nil {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	var candidates []string
+	if importer != nil && (strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../")) {
+		candidates = []string{filepath.Join(filepath.Dir(importer.filename), name)}
+	} else {
+		for _, path := range paths {
+			candidates = append(candidates, filepath.Join(path, name))
+		}
+	}
+
+	var notAllowed bool
+	for _, candidate := range candidates {
+		candidate, err := filepath.Abs(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !isInDirs(candidate, dirs) {
+			notAllowed = true
+			continue
+		}
+		info, err := os.Stat(candidate)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		candidate, err = filepath.EvalSymlinks(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !isInDirs(candidate, dirs) {
+			notAllowed = true
+			continue
+		}
+		return candidate, nil
+	}
+	if notAllowed {
+		return "", errors.New("module not allowed: " + name)
+	}
+	return "", errors.New("module not found: " + name)
+}
+
+// isInDirs returns true if the absolute path is in one of the directories or their subdirectories
+func isInDirs(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// importModule returns the Env of the module with the name, running the module if it has not been imported before.
+// Errors of the module itself carry its filename, other errors have the position of the import expression.
+// Modules can only be imported with a ModuleLoader, and if the Env has a PackageRegistry it has to allow modules.
+func (runInfo *runInfoStruct) importModule(expr *ast.ImportExpr, name string) (*env.Env, error) {
+	loader := runInfo.options.ModuleLoader
+	if loader == nil {
+		return nil, newStringError(expr, "module not found: "+name)
+	}
+	if registry := runInfo.env.PackageRegistry(); registry != nil && !registry.ModulesAllowed() {
+		return nil, newStringError(expr, "module not allowed: "+name)
+	}
+	filename, err := loader.resolve(name, runInfo.module)
+	if err != nil {
+		return nil, newError(expr, err)
+	}
+
+	cycle := []string{filename}
+	for importer := runInfo.module; importer != nil; importer = importer.importer {
+		cycle = append([]string{importer.filename}, cycle...)
+		if importer.filename == filename {
+			return nil, newStringError(expr, "import cycle: "+strings.Join(cycle, " -> "))
+		}
+	}
+
+	loader.mutex.Lock()
+	if loader.modules == nil {
+		loader.modules = make(map[string]*module)
+	}
+	m, found := loader.modules[filename]
+	if !found {
+		m = &module{done: make(chan struct{})}
+		loader.modules[filename] = m
+	}
+	loader.mutex.Unlock()
+
+	if found {
+		// the module could be running in another goroutine
+		select {
+		case <-m.done:
+			return m.env, m.err
+		case <-runInfo.ctx.Done():
+			return nil, ErrInterrupt
+		}
+	}
+
+	m.env, m.err = runInfo.runModule(filename)
+	if isRunError(m.err) {
+		// the next run can import the module
+		loader.mutex.Lock()
+		delete(loader.modules, filename)
+		loader.mutex.Unlock()
+	}
+	close(m.done)
+	return m.env, m.err
+}
+
+// isRunError returns true when err comes from the run importing the module rather than from the module itself,
+// like an interrupt or a limit of the Options
+func isRunError(err error) bool {
+	return errors.Is(err, ErrInterrupt) || isRunLimitError(err) || errors.Is(err, ErrStackOverflow) ||
+		errors.Is(err, ErrGoroutineLimit) || errors.Is(err, ErrContainerLengthLimit) || errors.Is(err, ErrStringLengthLimit)
+}
+
+// runModule runs the module file in a new Env
+func (runInfo *runInfoStruct) runModule(filename string) (*env.Env, error) {
+	source, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		if parseError, ok := err.(*parser.Error); ok {
+			parseError.Filename = filename
+		}
+		return nil, err
+	}
+
+	loader := runInfo.options.ModuleLoader
+	var moduleEnv *env.Env
+	if loader.NewEnv != nil {
+		moduleEnv = loader.NewEnv(runInfo.env.Global())
+	} else {
+		moduleEnv = runInfo.env.Global().Copy().NewEnv()
+	}
+	if registry := runInfo.env.PackageRegistry(); registry != nil && moduleEnv.PackageRegistry() == nil {
+		// the module can not import more than the script importing it
+		moduleEnv.SetPackageRegistry(registry)
+	}
+
+	moduleRunInfo := runInfoStruct{ctx: runInfo.ctx, env: moduleEnv, options: runInfo.options, stmt: stmt, rv: nilValue,
+		module: &moduleFile{filename: filename, importer: runInfo.module}, limits: runInfo.limits, callDepth: runInfo.callDepth, goroutines: runInfo.goroutines}
+	if !moduleRunInfo.options.Strict && hasStrictPragma(stmt) {
+		options := *moduleRunInfo.options
+		options.Strict = true
+		moduleRunInfo.options = &options
+	}
+	moduleRunInfo.runSingleStmt()
+	moduleRunInfo.runDeferredCalls()
+	if moduleRunInfo.err == ErrReturn {
+		moduleRunInfo.err = nil
+	}
+	if vmError, ok := moduleRunInfo.err.(*Error); ok && vmError.Filename == "" {
+		vmError.Filename = filename
+	}
+	return moduleEnv, moduleRunInfo.err
+}
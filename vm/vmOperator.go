@@ -75,6 +75,34 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if runInfo.compareWithMethods(operator, lhsV, runInfo.rv) {
+			return
+		}
+
+		if isBigNumber(lhsV) || isBigNumber(runInfo.rv) {
+			switch operator.Operator {
+			case "<":
+				runInfo.rv = reflect.ValueOf(compareBigNumbers(lhsV, runInfo.rv) < 0)
+				return
+			case "<=":
+				runInfo.rv = reflect.ValueOf(compareBigNumbers(lhsV, runInfo.rv) <= 0)
+				return
+			case ">":
+				runInfo.rv = reflect.ValueOf(compareBigNumbers(lhsV, runInfo.rv) > 0)
+				return
+			case ">=":
+				runInfo.rv = reflect.ValueOf(compareBigNumbers(lhsV, runInfo.rv) >= 0)
+				return
+			}
+		}
+
+		if operator.Operator != "==" && operator.Operator != "!=" && (lhsV.Kind() == reflect.Struct || runInfo.rv.Kind() == reflect.Struct) {
+			// structs without comparison methods have no order
+			runInfo.err = newStringError(operator, "type struct does not support comparison operation")
+			runInfo.rv = nilValue
+			return
+		}
+
 		switch operator.Operator {
 		case "==":
 			runInfo.rv = reflect.ValueOf(equal(lhsV, runInfo.rv))
@@ -114,6 +142,11 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if method, found := runInfo.operatorMethod(lhsV, operatorMethodNames[operator.Operator]); found {
+			runInfo.callOperatorMethod(operator, method, runInfo.rv)
+			return
+		}
+
 		switch operator.Operator {
 		case "+":
 			lhsKind := lhsV.Kind()
@@ -122,6 +155,11 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			if lhsKind == reflect.Slice || lhsKind == reflect.Array {
 				if rhsKind == reflect.Slice || rhsKind == reflect.Array {
 					// append slice to slice
+					runInfo.err = runInfo.checkContainerLength(operator, int64(lhsV.Len()+runInfo.rv.Len()), int64(runInfo.rv.Len())*typeSize(lhsV.Type().Elem()))
+					if runInfo.err != nil {
+						runInfo.rv = nilValue
+						return
+					}
 					runInfo.rv, runInfo.err = appendSlice(operator, lhsV, runInfo.rv)
 					return
 				}
@@ -132,6 +170,11 @@ func (runInfo *runInfoStruct) invokeOperator() {
 					runInfo.rv = nilValue
 					return
 				}
+				runInfo.err = runInfo.checkContainerLength(operator, int64(lhsV.Len()+1), typeSize(lhsV.Type().Elem()))
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
 				runInfo.rv = reflect.Append(lhsV, runInfo.rv)
 				return
 			}
@@ -142,10 +185,19 @@ func (runInfo *runInfoStruct) invokeOperator() {
 				return
 			}
 
+			if isBigNumber(lhsV) || isBigNumber(runInfo.rv) {
+				if lhsKind == reflect.String || rhsKind == reflect.String {
+					runInfo.concatStrings(operator, lhsV, runInfo.rv)
+					return
+				}
+				runInfo.runBigNumberOperator(operator, operator.Operator, lhsV, runInfo.rv)
+				return
+			}
+
 			kind := precedenceOfKinds(lhsKind, rhsKind)
 			switch kind {
 			case reflect.String:
-				runInfo.rv = reflect.ValueOf(toString(lhsV) + toString(runInfo.rv))
+				runInfo.concatStrings(operator, lhsV, runInfo.rv)
 			case reflect.Float64, reflect.Float32:
 				runInfo.rv = reflect.ValueOf(toFloat64(lhsV) + toFloat64(runInfo.rv))
 			default:
@@ -153,6 +205,10 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			}
 
 		case "-":
+			if isBigNumber(lhsV) || isBigNumber(runInfo.rv) {
+				runInfo.runBigNumberOperator(operator, operator.Operator, lhsV, runInfo.rv)
+				return
+			}
 			switch lhsV.Kind() {
 			case reflect.Float64, reflect.Float32:
 				runInfo.rv = reflect.ValueOf(toFloat64(lhsV) - toFloat64(runInfo.rv))
@@ -166,6 +222,10 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			}
 
 		case "|":
+			if isBigNumber(lhsV) || isBigNumber(runInfo.rv) {
+				runInfo.runBigNumberOperator(operator, operator.Operator, lhsV, runInfo.rv)
+				return
+			}
 			runInfo.rv = reflect.ValueOf(toInt64(lhsV) | toInt64(runInfo.rv))
 		default:
 			runInfo.err = newStringError(operator, "unknown operator")
@@ -193,10 +253,27 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if method, found := runInfo.operatorMethod(lhsV, operatorMethodNames[operator.Operator]); found {
+			runInfo.callOperatorMethod(operator, method, runInfo.rv)
+			return
+		}
+
+		if isBigNumber(lhsV) || isBigNumber(runInfo.rv) {
+			runInfo.runBigNumberOperator(operator, operator.Operator, lhsV, runInfo.rv)
+			return
+		}
+
 		switch operator.Operator {
 		case "*":
 			if lhsV.Kind() == reflect.String && (runInfo.rv.Kind() == reflect.Int || runInfo.rv.Kind() == reflect.Int32 || runInfo.rv.Kind() == reflect.Int64) {
-				runInfo.rv = reflect.ValueOf(strings.Repeat(toString(lhsV), int(toInt64(runInfo.rv))))
+				s := toString(lhsV)
+				count := toInt64(runInfo.rv)
+				runInfo.err = runInfo.checkStringLength(operator, multiplyLengths(int64(len(s)), count))
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
+				runInfo.rv = reflect.ValueOf(strings.Repeat(s, int(count)))
 				return
 			}
 			if lhsV.Kind() == reflect.Float64 || runInfo.rv.Kind() == reflect.Float64 {
@@ -226,3 +303,188 @@ func (runInfo *runInfoStruct) invokeOperator() {
 
 	}
 }
+
+// concatStrings sets rv to the concatenation of the values as strings, if it is within the string limits
+func (runInfo *runInfoStruct) concatStrings(operator ast.Operator, lhsV reflect.Value, rhsV reflect.Value) {
+	lhs, rhs := toString(lhsV), toString(rhsV)
+	runInfo.err = runInfo.checkStringLength(operator, int64(len(lhs)+len(rhs)))
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+		return
+	}
+	runInfo.rv = reflect.ValueOf(lhs + rhs)
+}
+
+// operatorMethodNames are the names of the methods that implement arithmetic operators.
+// Comparison operators use the Equal, Cmp, Compare and Less methods, see compareWithMethods.
+var operatorMethodNames = map[string]string{
+	"+": "Add",
+	"-": "Sub",
+	"*": "Mul",
+	"/": "Quo",
+}
+
+// operatorMethod returns the method of value with the name that can be used as an operator,
+// either a method defined by the script or a Go method, taking one argument and returning one value.
+// Only structs, pointers and named types are checked,
+// built-in types, big numbers and reflect.Value use the built-in operators.
+func (runInfo *runInfoStruct) operatorMethod(value reflect.Value, name string) (reflect.Value, bool) {
+	if name == "" || !value.IsValid() || isNil(value) || isBigNumber(value) || value.Type() == reflectValueType {
+		return reflect.Value{}, false
+	}
+	if value.Kind() != reflect.Struct && value.Kind() != reflect.Ptr && value.Type().PkgPath() == "" {
+		return reflect.Value{}, false
+	}
+
+	method, found := runInfo.getMethod(value, name)
+	if found {
+		if method.Type().NumIn() != 2 {
+			return reflect.Value{}, false
+		}
+		return method, true
+	}
+
+	method = value.MethodByName(name)
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 1 || methodType.NumOut() != 1 || methodType.IsVariadic() {
+		return reflect.Value{}, false
+	}
+	return method, true
+}
+
+// callOperatorMethod calls the operator method with rhsV as the argument, setting runInfo.rv to the result
+func (runInfo *runInfoStruct) callOperatorMethod(operator ast.Operator, method reflect.Value, rhsV reflect.Value) {
+	literalExpr := &ast.LiteralExpr{Literal: rhsV}
+	literalExpr.SetPosition(operator.Position())
+	callExpr := &ast.CallExpr{Func: method, SubExprs: []ast.Expr{literalExpr}}
+	callExpr.SetPosition(operator.Position())
+	runInfo.expr = callExpr
+	runInfo.invokeExpr()
+	if runInfo.err == nil && runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+		runInfo.rv = runInfo.rv.Elem()
+	}
+}
+
+// methodAcceptsOperand returns true when the operator method can be called with value as its argument.
+// Methods defined by the script accept any value.
+func methodAcceptsOperand(method reflect.Value, value reflect.Value) bool {
+	if !value.IsValid() {
+		return false
+	}
+	methodType := method.Type()
+	if checkIfRunVMFunction(methodType) {
+		return true
+	}
+	argType := methodType.In(0)
+	return value.Type().AssignableTo(argType) || value.Type().ConvertibleTo(argType)
+}
+
+// equalWithMethods returns true when lhsV and rhsV are the same value, like the == operator.
+// It uses the Equal, Cmp or Compare method of lhsV when it has one, otherwise equal.
+// Used by switch and in, so they match values the same way == does.
+func (runInfo *runInfoStruct) equalWithMethods(pos ast.Position, lhsV reflect.Value, rhsV reflect.Value) (bool, error) {
+	if lhsV.Kind() == reflect.Interface && !lhsV.IsNil() {
+		lhsV = lhsV.Elem()
+	}
+	if rhsV.Kind() == reflect.Interface && !rhsV.IsNil() {
+		rhsV = rhsV.Elem()
+	}
+
+	operator := &ast.ComparisonOperator{Operator: "=="}
+	operator.SetPosition(pos)
+	expr, rv := runInfo.expr, runInfo.rv
+	if !runInfo.compareWithMethods(operator, lhsV, rhsV) {
+		return equal(lhsV, rhsV), nil
+	}
+	result, err := toBool(runInfo.rv), runInfo.err
+	runInfo.expr, runInfo.rv, runInfo.err = expr, rv, nil
+	return result, err
+}
+
+// compareWithMethods does the comparison operator using the Equal, Cmp, Compare or Less method of the operands.
+// For == and != Equal is used if present, otherwise Cmp or Compare.
+// For == and != the method is only used when it accepts the type of the right operand,
+// otherwise the values are compared like values without methods, so time.Now() == 1 is false.
+// For the other operators Cmp or Compare is used if present, otherwise Less.
+// Returns false if the operands do not have the methods needed.
+func (runInfo *runInfoStruct) compareWithMethods(operator *ast.ComparisonOperator, lhsV reflect.Value, rhsV reflect.Value) bool {
+	if isNil(rhsV) {
+		return false
+	}
+
+	isEquality := operator.Operator == "==" || operator.Operator == "!="
+	if isEquality {
+		method, found := runInfo.operatorMethod(lhsV, "Equal")
+		if found && methodAcceptsOperand(method, rhsV) {
+			runInfo.callOperatorMethod(operator, method, rhsV)
+			if runInfo.err != nil {
+				return true
+			}
+			equal := toBool(runInfo.rv)
+			runInfo.rv = reflect.ValueOf(equal == (operator.Operator == "=="))
+			return true
+		}
+	}
+
+	method, found := runInfo.operatorMethod(lhsV, "Cmp")
+	if !found {
+		method, found = runInfo.operatorMethod(lhsV, "Compare")
+	}
+	if found && isEquality && !methodAcceptsOperand(method, rhsV) {
+		return false
+	}
+	if found {
+		runInfo.callOperatorMethod(operator, method, rhsV)
+		if runInfo.err != nil {
+			return true
+		}
+		cmp := toInt64(runInfo.rv)
+		switch operator.Operator {
+		case "==":
+			runInfo.rv = reflect.ValueOf(cmp == 0)
+		case "!=":
+			runInfo.rv = reflect.ValueOf(cmp != 0)
+		case "<":
+			runInfo.rv = reflect.ValueOf(cmp < 0)
+		case "<=":
+			runInfo.rv = reflect.ValueOf(cmp <= 0)
+		case ">":
+			runInfo.rv = reflect.ValueOf(cmp > 0)
+		case ">=":
+			runInfo.rv = reflect.ValueOf(cmp >= 0)
+		default:
+			runInfo.err = newStringError(operator, "unknown operator")
+			runInfo.rv = nilValue
+		}
+		return true
+	}
+
+	// a > b is b < a, a <= b is !(b < a) and a >= b is !(a < b)
+	var negate bool
+	switch operator.Operator {
+	case "<":
+	case ">=":
+		negate = true
+	case ">":
+		lhsV, rhsV = rhsV, lhsV
+	case "<=":
+		lhsV, rhsV = rhsV, lhsV
+		negate = true
+	default:
+		return false
+	}
+
+	method, found = runInfo.operatorMethod(lhsV, "Less")
+	if !found {
+		return false
+	}
+	runInfo.callOperatorMethod(operator, method, rhsV)
+	if runInfo.err != nil {
+		return true
+	}
+	runInfo.rv = reflect.ValueOf(toBool(runInfo.rv) != negate)
+	return true
+}
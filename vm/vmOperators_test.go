@@ -1,9 +1,13 @@
 package vm
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestBasicOperators(t *testing.T) {
@@ -381,6 +385,146 @@ func TestComparisonOperators(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestBigNumbers(t *testing.T) {
+	tests := []Test{
+		{Script: `123n`, RunOutput: big.NewInt(123)},
+		{Script: `-123n`, RunOutput: big.NewInt(-123)},
+		{Script: `0xffn`, RunOutput: big.NewInt(255)},
+		{Script: `12.30d`, RunOutput: big.NewRat(123, 10)},
+		{Script: `1.5n`, ParseError: fmt.Errorf("invalid number: 1.5n")},
+		{Script: `1e3n`, ParseError: fmt.Errorf("invalid number: 1e3n")},
+		{Script: `1nx`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `"" + 123456789012345678901234567890n * 10`, RunOutput: "1234567890123456789012345678900"},
+		{Script: `"" + (9223372036854775807 + 1n)`, RunOutput: "9223372036854775808"},
+		{Script: `"" + (-9223372036854775808 - 1n)`, RunOutput: "-9223372036854775809"},
+		{Script: `"" + 1n << 100`, RunOutput: "1267650600228229401496703205376"},
+		{Script: `1n - 3`, RunOutput: big.NewInt(-2)},
+		{Script: `7n % 3`, RunOutput: big.NewInt(1)},
+		{Script: `6n | 1`, RunOutput: big.NewInt(7)},
+		{Script: `6n & 3`, RunOutput: big.NewInt(2)},
+		{Script: `-5n`, RunOutput: big.NewInt(-5)},
+		{Script: `^5n`, RunOutput: big.NewInt(-6)},
+		{Script: `a = 1n; a += 2; a++; a`, RunOutput: big.NewInt(4), Output: map[string]interface{}{"a": big.NewInt(4)}},
+		{Script: `1n / 4n`, RunOutput: big.NewRat(1, 4)},
+		{Script: `1n / 0`, RunError: fmt.Errorf("division by zero")},
+		{Script: `1n % 0`, RunError: fmt.Errorf("division by zero")},
+		{Script: `1n << -1`, RunError: fmt.Errorf("negative shift count")},
+		{Script: `1n << -100000000000000000000n`, RunError: fmt.Errorf("negative shift count")},
+		{Script: `1n << 1048577`, RunError: fmt.Errorf("shift count too large")},
+		{Script: `1n << 100000000000000000000n`, RunError: fmt.Errorf("shift count too large")},
+		{Script: `(1n << 1048576).BitLen()`, RunOutput: 1048577},
+		{Script: `1n >> 100000000000000000000n`, RunOutput: big.NewInt(0)},
+
+		{Script: `"" + (0.1d + 0.2d)`, RunOutput: "0.3"},
+		{Script: `"" + 12.30d`, RunOutput: "12.3"},
+		{Script: `"" + 1d / 3`, RunOutput: "0.3333333333333333333333333333333333"},
+		{Script: `"" + (1n + 1.5)`, RunOutput: "2.5"},
+		{Script: `"" + 2d * 3`, RunOutput: "6"},
+		{Script: `-1.5d`, RunOutput: big.NewRat(-3, 2)},
+		{Script: `"a" + 1n`, RunOutput: "a1"},
+		{Script: `1.5d + "a"`, RunOutput: "1.5a"},
+
+		{Script: `1n == 1`, RunOutput: true},
+		{Script: `1 == 1n`, RunOutput: true},
+		{Script: `1n != 1`, RunOutput: false},
+		{Script: `1.5d == 1.5`, RunOutput: true},
+		{Script: `2n == 2d`, RunOutput: true},
+		{Script: `1n == "a"`, RunOutput: false},
+		{Script: `1n == nil`, RunOutput: false},
+		{Script: `1n < 2`, RunOutput: true},
+		{Script: `1n <= 1`, RunOutput: true},
+		{Script: `3 > 2n`, RunOutput: true},
+		{Script: `2.5 >= 2.5d`, RunOutput: true},
+		{Script: `99999999999999999999n > 9223372036854775807`, RunOutput: true},
+
+		{Script: `a = 5n; if a { a = true }; a`, RunOutput: true},
+		{Script: `a = 0d; if a { a = true }; a`, RunOutput: big.NewRat(0, 1)},
+		{Script: `a = [1, 2, 3]; a[1n]`, RunOutput: int64(2)},
+		{Script: `a = [1, 2, 3]; a[1.5d]`, RunOutput: int64(2)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+type testMoney struct {
+	Cents int64
+}
+
+func (m testMoney) Add(o testMoney) testMoney { return testMoney{Cents: m.Cents + o.Cents} }
+func (m testMoney) Sub(o testMoney) testMoney { return testMoney{Cents: m.Cents - o.Cents} }
+func (m testMoney) Mul(n int64) testMoney     { return testMoney{Cents: m.Cents * n} }
+func (m testMoney) Quo(n int64) testMoney     { return testMoney{Cents: m.Cents / n} }
+func (m testMoney) Cmp(o testMoney) int {
+	switch {
+	case m.Cents < o.Cents:
+		return -1
+	case m.Cents > o.Cents:
+		return 1
+	}
+	return 0
+}
+
+type testVersion int64
+
+func (v testVersion) Less(o testVersion) bool { return v < o }
+
+func TestOperatorOverloading(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := date.Add(time.Hour)
+	now := time.Now()
+	money := func(cents int64) testMoney { return testMoney{Cents: cents} }
+	tests := []Test{
+		{Script: `a + b`, Input: map[string]interface{}{"a": date, "b": time.Hour}, RunOutput: later},
+		{Script: `a + 3600000000000`, Input: map[string]interface{}{"a": date}, RunOutput: later},
+		{Script: `b - a`, Input: map[string]interface{}{"a": date, "b": later}, RunOutput: time.Hour},
+		{Script: `a == b`, Input: map[string]interface{}{"a": date, "b": later.Add(-time.Hour).In(time.FixedZone("a", 3600))}, RunOutput: true},
+		{Script: `a != b`, Input: map[string]interface{}{"a": date, "b": later}, RunOutput: true},
+		{Script: `a == nil`, Input: map[string]interface{}{"a": date}, RunOutput: false},
+		{Script: `a == 1`, Input: map[string]interface{}{"a": date}, RunOutput: false},
+		{Script: `a != "b"`, Input: map[string]interface{}{"a": date}, RunOutput: true},
+		{Script: `b = a.Round(0); b == a`, Input: map[string]interface{}{"a": now}, RunOutput: true},
+		{Script: `b = a.Round(0); switch b { case a: return 1 }; return 2`, Input: map[string]interface{}{"a": now}, RunOutput: int64(1)},
+		{Script: `b = a.Round(0); b in [1, a]`, Input: map[string]interface{}{"a": now}, RunOutput: true},
+		{Script: `a in [1, "b", nil]`, Input: map[string]interface{}{"a": date}, RunOutput: false},
+		{Script: `a + "b"`, Input: map[string]interface{}{"a": date}, RunError: fmt.Errorf("function wants argument type time.Duration but received type string")},
+		{Script: `a < a.Add(5)`, Input: map[string]interface{}{"a": date}, RunOutput: true},
+		{Script: `[a < b, a > b, a <= a, a >= b]`, Input: map[string]interface{}{"a": date, "b": later}, RunOutput: []interface{}{true, false, true, false}},
+		{Script: `a < b`, Input: map[string]interface{}{"a": struct{ A int64 }{A: 1}, "b": struct{ A int64 }{A: 2}}, RunError: fmt.Errorf("type struct does not support comparison operation")},
+		{Script: `a >= 1`, Input: map[string]interface{}{"a": struct{ A int64 }{A: 1}}, RunError: fmt.Errorf("type struct does not support comparison operation")},
+		{Script: `a == b`, Input: map[string]interface{}{"a": struct{ A int64 }{A: 1}, "b": struct{ A int64 }{A: 1}}, RunOutput: true},
+
+		{Script: `a + b`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: money(400)},
+		{Script: `a - b`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: money(-100)},
+		{Script: `a * 3`, Input: map[string]interface{}{"a": money(150)}, RunOutput: money(450)},
+		{Script: `a / 2`, Input: map[string]interface{}{"a": money(150)}, RunOutput: money(75)},
+		{Script: `a += b; a`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: money(400), Output: map[string]interface{}{"a": money(400), "b": money(250)}},
+		{Script: `a < b`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: true},
+		{Script: `a <= b`, Input: map[string]interface{}{"a": money(250), "b": money(250)}, RunOutput: true},
+		{Script: `a > b`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: false},
+		{Script: `a >= b`, Input: map[string]interface{}{"a": money(150), "b": money(250)}, RunOutput: false},
+		{Script: `a == b`, Input: map[string]interface{}{"a": money(250), "b": money(250)}, RunOutput: true},
+		{Script: `a != b`, Input: map[string]interface{}{"a": money(250), "b": money(250)}, RunOutput: false},
+		{Script: `a == 250`, Input: map[string]interface{}{"a": money(250)}, RunOutput: false},
+		{Script: `a != 250`, Input: map[string]interface{}{"a": money(250)}, RunOutput: true},
+		{Script: `switch a { case 250: return 1; case b: return 2 }`, Input: map[string]interface{}{"a": money(250), "b": money(250)}, RunOutput: int64(2)},
+
+		{Script: `a < b`, Input: map[string]interface{}{"a": testVersion(1), "b": testVersion(2)}, RunOutput: true},
+		{Script: `a > b`, Input: map[string]interface{}{"a": testVersion(1), "b": testVersion(2)}, RunOutput: false},
+		{Script: `a <= b`, Input: map[string]interface{}{"a": testVersion(2), "b": testVersion(2)}, RunOutput: true},
+		{Script: `a >= b`, Input: map[string]interface{}{"a": testVersion(1), "b": testVersion(2)}, RunOutput: false},
+		{Script: `a + b`, Input: map[string]interface{}{"a": testVersion(1), "b": testVersion(2)}, RunOutput: int64(3)},
+
+		{Script: `make(type a, struct { A int64 }); func (b a) Add(c) { d = make(a); d.A = b.A + c.A; return d }; b = make(a); b.A = 1; c = make(a); c.A = 2; (b + c).A`, RunOutput: int64(3)},
+		{Script: `make(type a, struct { A int64 }); func (b a) Less(c) { return b.A < c.A }; b = make(a); b.A = 1; c = make(a); c.A = 2; [b < c, b > c, b <= b, b >= c]`, RunOutput: []interface{}{true, false, true, false}},
+		{Script: `make(type a, struct { A int64 }); func (b a) Equal(c) { return true }; b = make(a); b.A = 1; c = make(a); c.A = 2; b == c`, RunOutput: true},
+		{Script: `make(type a, struct { A int64 }); func (b a) Add(c) { throw "no" }; b = make(a); b + b`, RunError: fmt.Errorf("no")},
+		{Script: `make(type a, struct { A int64 }); func (b a) Equal(c) { throw "no" }; b = make(a); switch b { case b: return 1 }`, RunError: fmt.Errorf("no")},
+		{Script: `make(type a, struct { A int64 }); func (b a) Equal(c) { throw "no" }; b = make(a); b in [b]`, RunError: fmt.Errorf("no")},
+		{Script: `func (a int64) Add(b) { return 0 }; 1 + 2`, RunOutput: int64(3)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestThrows(t *testing.T) {
 	tests := []Test{
 		{Script: `throw(1++)`, RunError: fmt.Errorf("invalid operation")},
@@ -492,6 +636,50 @@ func TestNilCoalescingOperator(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestOptionalChaining(t *testing.T) {
+	tests := []Test{
+		{Script: `a?.b`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a?.b.c.d`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a.b`, Input: map[string]interface{}{"a": nil}, RunError: fmt.Errorf("type interface does not support member operation")},
+		{Script: `a = {"b": {"c": 1}}; a?.b.c`, RunOutput: int64(1)},
+		{Script: `a = {"b": {"c": 1}}; a?.x.c`, RunOutput: nil},
+		{Script: `a = {"b": {"c": 1}}; a?.b?.x?.y`, RunOutput: nil},
+		{Script: `a = {"b": {"c": 1}}; a.x?.c.d`, RunOutput: nil},
+		{Script: `a = {"b": {"c": 1}}; a.x.c`, RunError: fmt.Errorf("type interface does not support member operation")},
+		{Script: `a = {"b": 1}; a?.b.c`, RunError: fmt.Errorf("type int64 does not support member operation")},
+		{Script: `a?.b`, Input: map[string]interface{}{"a": (*struct{ B int64 })(nil)}, RunOutput: nil},
+		{Script: `a?.B`, Input: map[string]interface{}{"a": &struct{ B int64 }{B: 1}}, RunOutput: int64(1)},
+
+		{Script: `a?.[1]`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a?.[b]`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a?.[1][2]`, Input: map[string]interface{}{"a": []interface{}(nil)}, RunOutput: nil},
+		{Script: `a = [1, [2, 3]]; a?.[1][0]`, RunOutput: int64(2)},
+		{Script: `a = [1, nil]; a[1]?.[0].b`, RunOutput: nil},
+		{Script: `a = [1]; a?.[1]`, RunError: fmt.Errorf("index out of range")},
+		{Script: `a = {"b": {"c": 1}}; a?.["x"]["c"]`, RunOutput: nil},
+		{Script: `a = {"b": [{"c": 1}]}; a?.b[0]?.["c"]`, RunOutput: int64(1)},
+		{Script: `true ?[1] : [2]`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = nil; a ?[1] : [2]`, RunOutput: []interface{}{int64(2)}},
+		{Script: `true ?.[1] : [2]`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `true ? [1] : [2]`, RunOutput: []interface{}{int64(1)}},
+
+		{Script: `a?.()`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a?.(b)`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `a?.()`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `a = func(b) { return b + 1 }; a?.(1)`, RunOutput: int64(2)},
+		{Script: `a = {}; a?.b()`, RunOutput: nil},
+		{Script: `a = {}; a?.b().c`, RunOutput: nil},
+		{Script: `a = {"b": func() { return {"c": 1} }}; a?.b().c`, RunOutput: int64(1)},
+		{Script: `a = {}; a.b()`, RunError: fmt.Errorf("cannot call type interface")},
+		{Script: `a = 1; a?.()`, RunError: fmt.Errorf("cannot call type int64")},
+
+		{Script: `a = {"b": {"c": 1}}; a?.x.c ?? 2`, RunOutput: int64(2)},
+		{Script: `a = {"b": {"c": 1}}; a?.b.c ?? 2`, RunOutput: int64(1)},
+		{Script: `a = {"b": {"c": 1}}; b = a?.x?.c; b`, RunOutput: nil, Output: map[string]interface{}{"b": nil}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestIf(t *testing.T) {
 	tests := []Test{
 		{Script: `if 1++ {}`, RunError: fmt.Errorf("invalid operation")},
@@ -545,7 +733,7 @@ func TestIf(t *testing.T) {
 func TestSwitch(t *testing.T) {
 	tests := []Test{
 		// test parse errors
-		{Script: `switch {}`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `switch {case}`, ParseError: fmt.Errorf("syntax error")},
 		{Script: `a = 1; switch a; {}`, ParseError: fmt.Errorf("syntax error")},
 		{Script: `a = 1; switch a = 2 {}`, ParseError: fmt.Errorf("syntax error")},
 		{Script: `a = 1; switch a {default: return 6; default: return 7}`, ParseError: fmt.Errorf("multiple default statement"), RunOutput: int64(7)},
@@ -633,6 +821,150 @@ switch a {
 	case 1:
 		return 1
 }`, RunOutput: int64(1)},
+
+		// test without expression
+		{Script: `switch {}`},
+		{Script: `switch {case 1++: return 2}`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `a = 1; switch {case a > 1: return 5}`, Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a = 2; switch {case a > 1: return 5}`, RunOutput: int64(5), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `a = 1; switch {case a > 1: return 5; default: return 6}`, RunOutput: int64(6), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a = 1; switch {case a > 1, a < 1: return 5; case a == 1: return 6}`, RunOutput: int64(6), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a = 0; switch {case a: return 5; case "a": return 6}`, RunOutput: int64(6), Output: map[string]interface{}{"a": int64(0)}},
+		{Script: `
+a = 15
+switch {
+	case a > 10:
+		return "a"
+	case a > 5:
+		return "b"
+}`, RunOutput: "a"},
+
+		// test fallthrough
+		{Script: `fallthrough`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunError: fmt.Errorf("unexpected fallthrough statement")},
+		{Script: `a = 1; switch a {case 1: fallthrough}`, ParseError: fmt.Errorf("cannot fallthrough final case in switch"), RunError: fmt.Errorf("unexpected fallthrough statement")},
+		{Script: `a = 1; switch a {case 1: fallthrough; case 2: return 6; default: fallthrough}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunOutput: int64(6)},
+		{Script: `a = 1; switch a {default: fallthrough}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunError: fmt.Errorf("unexpected fallthrough statement")},
+		{Script: `a = 1; switch a {case 1: fallthrough; b = 1; case 2: return 6}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case 1: if true { fallthrough }; case 2: return 6}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case 1: for { fallthrough }; case 2: return 6}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case 1: func() { fallthrough }(); case 2: return 6}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunError: fmt.Errorf("unexpected fallthrough statement")},
+		{Script: `a = 1; switch a.(type) {case int64: fallthrough; default: return 6}`, ParseError: fmt.Errorf("fallthrough statement out of place"), RunError: fmt.Errorf("unexpected fallthrough statement")},
+		{Script: `a = 1; switch a {case 1: fallthrough; default: return 6; case 2: return 7}`, RunOutput: int64(7)},
+		{Script: `a = 1; switch a {case 2: return 7; case 1: fallthrough; default: return 6}`, RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case 1: b = 1; fallthrough; case 2: return 6}`, RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case 1: fallthrough; case 2: fallthrough; default: return 7}`, RunOutput: int64(7)},
+		{Script: `a = 2; switch a {case 1: return 5; case 2: fallthrough; case 3: return 6}`, RunOutput: int64(6)},
+		{Script: `a = 2; switch a {case 1: fallthrough; case 2: return 6}`, RunOutput: int64(6)},
+		{Script: `switch {case true: fallthrough; case false: return 6}`, RunOutput: int64(6)},
+		{Script: `for i in [1] { switch i {case 1: fallthrough; case 2: break}; return 5 }; return 6`, RunOutput: int64(6)},
+		{Script: `
+a = []
+switch 1 {
+	case 1:
+		a += 1
+		fallthrough
+	case 2:
+		a += 2
+	case 3:
+		a += 3
+}
+a`, RunOutput: []interface{}{int64(1), int64(2)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestSwitchStrict(t *testing.T) {
+	tests := []Test{
+		{Script: `a = 1; switch a {case 1: return 5}`, RunOutput: int64(5)},
+		{Script: `a = 1; switch a {case "1": return 5}`},
+		{Script: `a = "1"; switch a {case 1: return 5}`},
+		{Script: `a = 1; switch a {case 1.0: return 5}`},
+		{Script: `a = 1; switch a {case "1": return 5; case 1: return 6}`, RunOutput: int64(6)},
+		{Script: `a = 1; switch a {case "1": return 5; default: return 6}`, RunOutput: int64(6)},
+		{Script: `a = nil; switch a {case nil: return 5}`, RunOutput: int64(5)},
+		{Script: `b = a.Round(0); switch b {case a: return 5}`, Input: map[string]interface{}{"a": time.Now()}, RunOutput: int64(5)},
+		{Script: `a = nil; switch a {case 0: return 5}`},
+		{Script: `a = 1; switch a {case nil: return 5}`},
+		{Script: `switch a {case 1: return 5}`, Input: map[string]interface{}{"a": int32(1)}},
+		{Script: `switch a {case b: return 5}`, Input: map[string]interface{}{"a": int32(1), "b": int32(1)}, RunOutput: int64(5)},
+		{Script: `a = [1]; switch a {case [1]: return 5}`, RunOutput: int64(5)},
+		{Script: `a = 2; switch {case a > 1: return 5}`, RunOutput: int64(5)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, StrictSwitch: true})
+}
+
+func TestTypeAssertions(t *testing.T) {
+	tests := []Test{
+		{Script: `a.(string`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a.()`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a.(string)`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `a.(foo)`, Input: map[string]interface{}{"a": "a"}, RunError: fmt.Errorf("undefined type 'foo'")},
+		{Script: `a.(nilT)`, Input: map[string]interface{}{"a": "a"}, Types: map[string]interface{}{"nilT": nil}, RunError: fmt.Errorf("cannot assert type nil")},
+		{Script: `a.(int64)`, Input: map[string]interface{}{"a": "a"}, RunError: fmt.Errorf("type assertion failed: string is not int64")},
+		{Script: `a.(string)`, Input: map[string]interface{}{"a": nil}, RunError: fmt.Errorf("type assertion failed: nil is not string")},
+		{Script: `a.(int)`, RunError: fmt.Errorf("type assertion failed: int64 is not int"), Input: map[string]interface{}{"a": int64(1)}},
+
+		{Script: `a.(string)`, Input: map[string]interface{}{"a": "a"}, RunOutput: "a"},
+		{Script: `a.(int64)`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(1)},
+		{Script: `a.(int)`, Input: map[string]interface{}{"a": int(1)}, RunOutput: int(1)},
+		{Script: `a.(interface)`, Input: map[string]interface{}{"a": "a"}, RunOutput: "a"},
+		{Script: `a.([]interface)`, Input: map[string]interface{}{"a": []interface{}{int64(1)}}, RunOutput: []interface{}{int64(1)}},
+		{Script: `a.(map[string]int64)`, Input: map[string]interface{}{"a": map[string]int64{"a": 1}}, RunOutput: map[string]int64{"a": 1}},
+		{Script: `a = 1; a.(int64) + 1`, RunOutput: int64(2)},
+		{Script: `a.(myInt)`, Input: map[string]interface{}{"a": int32(1)}, Types: map[string]interface{}{"myInt": int32(0)}, RunOutput: int32(1)},
+		{Script: `a.(error)`, Input: map[string]interface{}{"a": fmt.Errorf("a")}, Types: map[string]interface{}{"error": errorType}, RunOutput: fmt.Errorf("a")},
+		{Script: `time = import("time"); a.(time.Duration)`, Input: map[string]interface{}{"a": time.Second}, RunOutput: time.Second},
+
+		{Script: `v, ok = a.(string)`, Input: map[string]interface{}{"a": "a"}, RunOutput: true, Output: map[string]interface{}{"v": "a", "ok": true}},
+		{Script: `v, ok = a.(string)`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: false, Output: map[string]interface{}{"v": "", "ok": false}},
+		{Script: `v, ok = a.(int64)`, Input: map[string]interface{}{"a": nil}, RunOutput: false, Output: map[string]interface{}{"v": int64(0), "ok": false}},
+		{Script: `v, ok = a.(error)`, Input: map[string]interface{}{"a": "a"}, Types: map[string]interface{}{"error": errorType}, RunOutput: false, Output: map[string]interface{}{"v": nil, "ok": false}},
+		{Script: `v, ok = a.(foo)`, Input: map[string]interface{}{"a": "a"}, RunError: fmt.Errorf("undefined type 'foo'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestTypeSwitch(t *testing.T) {
+	tests := []Test{
+		{Script: `switch a.(type) {default: return 6; default: return 7}`, Input: map[string]interface{}{"a": int64(1)}, ParseError: fmt.Errorf("multiple default statement"), RunOutput: int64(7)},
+		{Script: `switch v = a.(type) {}`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `switch a.(type) {}`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `switch a.(type) {case foo: return 1}`, Input: map[string]interface{}{"a": int64(1)}, RunError: fmt.Errorf("undefined type 'foo'")},
+
+		{Script: `switch a.(type) {}`, Input: map[string]interface{}{"a": int64(1)}},
+		{Script: `switch a.(type) {case string: return 1}`, Input: map[string]interface{}{"a": int64(1)}},
+		{Script: `switch a.(type) {case string: return 1; case int64: return 2}`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(2)},
+		{Script: `switch a.(type) {case string, int64: return 1; default: return 2}`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(1)},
+		{Script: `switch a.(type) {case string: return 1; default: return 2}`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(2)},
+		{Script: `switch a.(type) {case nil: return 1; default: return 2}`, Input: map[string]interface{}{"a": nil}, RunOutput: int64(1)},
+		{Script: `switch a.(type) {case string: return 1; case nil: return 2}`, Input: map[string]interface{}{"a": nil}, RunOutput: int64(2)},
+		{Script: `switch a.(type) {case interface: return 1; case nil: return 2}`, Input: map[string]interface{}{"a": nil}, RunOutput: int64(2)},
+		{Script: `switch a.(type) {case interface: return 1; case nil: return 2}`, Input: map[string]interface{}{"a": "a"}, RunOutput: int64(1)},
+		{Script: `switch a.(type) {case []interface: return 1; case []string: return 2}`, Input: map[string]interface{}{"a": []string{"a"}}, RunOutput: int64(2)},
+		{Script: `switch a.(type) {case myInt: return 1}`, Input: map[string]interface{}{"a": int32(1)}, Types: map[string]interface{}{"myInt": int32(0)}, RunOutput: int64(1)},
+		{Script: `switch a.(type) {case error: return 1}`, Input: map[string]interface{}{"a": fmt.Errorf("a")}, Types: map[string]interface{}{"error": errorType}, RunOutput: int64(1)},
+		{Script: `time = import("time"); switch a.(type) {case time.Duration: return 1}`, Input: map[string]interface{}{"a": time.Second}, RunOutput: int64(1)},
+
+		{Script: `switch v := a.(type) {case string: return v + "b"}`, Input: map[string]interface{}{"a": "a"}, RunOutput: "ab"},
+		{Script: `switch v := a.(type) {case string: return v + "b"; default: return v}`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(1)},
+		{Script: `switch v := a.(type) {case nil: return v}`, Input: map[string]interface{}{"a": nil}, RunOutput: nil},
+		{Script: `v = 1; switch v := a.(type) {case string: v}; v`, Input: map[string]interface{}{"a": "a"}, RunOutput: int64(1), Output: map[string]interface{}{"v": int64(1)}},
+		{Script: `switch v := a.(type) {case string: v}; v`, Input: map[string]interface{}{"a": "a"}, RunError: fmt.Errorf("undefined symbol 'v'")},
+		{Script: `
+b = []
+for x in a {
+	switch v := x.(type) {
+		case int64, float64:
+			b += v + 1
+		case string:
+			b += v + "b"
+		case []interface:
+			b += len(v)
+	}
+}
+b`, Input: map[string]interface{}{"a": []interface{}{int64(1), "a", []interface{}{int64(1), int64(2)}}}, RunOutput: []interface{}{int64(2), "ab", int64(2)}},
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
@@ -835,6 +1167,166 @@ func TestForLoop(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+type testList struct {
+	values []int64
+}
+
+func (l *testList) All() func(func(int, int64) bool) {
+	return func(yield func(int, int64) bool) {
+		for i, value := range l.values {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+type testAllWithArgument struct{}
+
+func (testAllWithArgument) All(int) func(func(int) bool) { return nil }
+
+type testPanicAll struct{}
+
+func (testPanicAll) All() func(func(int64) bool) { panic("all") }
+
+func TestForLoopIterators(t *testing.T) {
+	seq := func(n int) func(func(int) bool) {
+		return func(yield func(int) bool) {
+			for i := 0; i < n; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+	seq2 := func(yield func(string, int64) bool) {
+		if !yield("a", 1) {
+			return
+		}
+		yield("b", 2)
+	}
+	badSeq := func(yield func(int) bool) {
+		yield(1)
+		yield(2)
+	}
+
+	tests := []Test{
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": func() {}}, RunError: fmt.Errorf("for cannot loop over type func()")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": func(int) {}}, RunError: fmt.Errorf("for cannot loop over type func(int)")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": func(func(int)) {}}, RunError: fmt.Errorf("for cannot loop over type func(func(int))")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": func(func(int, int, int) bool) {}}, RunError: fmt.Errorf("for cannot loop over type func(func(int, int, int) bool)")},
+		{Script: `for a, b in c {}`, Input: map[string]interface{}{"c": seq(1)}, RunError: fmt.Errorf("for has more variables than func(func(int) bool) yields")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": (func(func(int) bool))(nil)}, RunError: fmt.Errorf("for cannot loop over nil func")},
+		{Script: `for a in func() {} {}`, RunError: fmt.Errorf("for cannot loop over func with 0 arguments")},
+		{Script: `for a in func(a, b) {} {}`, RunError: fmt.Errorf("for cannot loop over func with 2 arguments")},
+		{Script: `for a in b { break }`, Input: map[string]interface{}{"b": badSeq}, RunError: fmt.Errorf("for iterator continued after loop exit")},
+		{Script: `for a in b { a.c }`, Input: map[string]interface{}{"b": seq(1)}, RunError: fmt.Errorf("type int does not support member operation")},
+		{Script: `for a in func(yield) { throw "a" } {}`, RunError: fmt.Errorf("a")},
+
+		{Script: `a = []; for b in c { a += b }; a`, Input: map[string]interface{}{"c": seq(0)}, RunOutput: []interface{}{}},
+		{Script: `a = []; for b in c { a += b }; a`, Input: map[string]interface{}{"c": seq(3)}, RunOutput: []interface{}{0, 1, 2}},
+		{Script: `a = []; for b in c { if b == 1 { continue }; a += b }; a`, Input: map[string]interface{}{"c": seq(3)}, RunOutput: []interface{}{0, 2}},
+		{Script: `a = []; for b in c { if b == 1 { break }; a += b }; a`, Input: map[string]interface{}{"c": seq(3)}, RunOutput: []interface{}{0}},
+		{Script: `func a() { for b in c { if b == 2 { return b } } }; a()`, Input: map[string]interface{}{"c": seq(5)}, RunOutput: 2},
+		{Script: `a = []; for b, c in d { a += b; a += c }; a`, Input: map[string]interface{}{"d": seq2}, RunOutput: []interface{}{"a", int64(1), "b", int64(2)}},
+		{Script: `a = []; for b in c { a += b }; a`, Input: map[string]interface{}{"c": seq2}, RunOutput: []interface{}{"a", "b"}},
+		{Script: `a = []; for b, c in d { a += b; break }; a`, Input: map[string]interface{}{"d": seq2}, RunOutput: []interface{}{"a"}},
+		{Script: `a = 0; b: for c in d { for e in d { if e == 1 { continue b }; a++ } }; a`, Input: map[string]interface{}{"d": seq(3)}, RunOutput: int64(3)},
+
+		{Script: `a = []; for b in func(yield) { yield(1); yield(2) } { a += b }; a`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a = []; for b in func(yield) { for c in [1, 2, 3] { if !yield(c) { return } } } { if b == 2 { break }; a += b }; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = {}; for b, c in func(yield) { yield("a", 1); yield("b", 2) } { a[b] = c }; a`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = func(yield) { yield(1); yield(2) }; for b in a { break }`, RunError: fmt.Errorf("for iterator continued after loop exit")},
+
+		{Script: `a = []; for b, c in d { a += b; a += c }; a`, Input: map[string]interface{}{"d": &testList{values: []int64{5, 6}}}, RunOutput: []interface{}{0, int64(5), 1, int64(6)}},
+		{Script: `a = []; for b in d { a += b }; a`, Input: map[string]interface{}{"d": &testList{values: []int64{5, 6}}}, RunOutput: []interface{}{0, 1}},
+		{Script: `[c for b, c in d if c > 5]`, Input: map[string]interface{}{"d": &testList{values: []int64{5, 6}}}, RunOutput: []interface{}{int64(6)}},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": testList{}}, RunError: fmt.Errorf("for cannot loop over type struct")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": testAllWithArgument{}}, RunError: fmt.Errorf("for cannot loop over type struct")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": testPanicAll{}}, RunError: fmt.Errorf("all")},
+		{Script: `for a in b {}`, Input: map[string]interface{}{"b": (*testPanicAll)(nil)}, RunError: fmt.Errorf("value method github.com/gbl08ma/anko/vm.testPanicAll.All called using nil *testPanicAll pointer")},
+	}
+	runTests(t, tests, nil, &Options{Debug: false})
+}
+
+func TestComprehensions(t *testing.T) {
+	tests := []Test{
+		{Script: `[x for x in [1, 2, 3]]`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `a = [1, -2, 3]; [x * 2 for x in a if x > 0]`, RunOutput: []interface{}{int64(2), int64(6)}},
+		{Script: `[x for x in []]`, RunOutput: []interface{}{}},
+		{Script: `[x for x in [1, 2] if false]`, RunOutput: []interface{}{}},
+		{Script: `[x for x in a]`, Input: map[string]interface{}{"a": []string{"a", "b"}}, RunOutput: []interface{}{"a", "b"}},
+		{Script: `[
+	x + 1 for x in [1, 2]
+]`, RunOutput: []interface{}{int64(2), int64(3)}},
+		{Script: `[k for k in {"a": 1}]`, RunOutput: []interface{}{"a"}},
+		{Script: `[v for k, v in {"a": 1}]`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = make(chan int64, 2); a <- 1; a <- 2; close(a); [x * 10 for x in a]`, RunOutput: []interface{}{int64(10), int64(20)}},
+		{Script: `[[x, y] for x, y in a]`, Input: map[string]interface{}{"a": func(yield func(int64, string) bool) { yield(1, "a") }}, RunOutput: []interface{}{[]interface{}{int64(1), "a"}}},
+
+		{Script: `{k: v * 10 for k, v in {"a": 1, "b": 2}}`, RunOutput: map[interface{}]interface{}{"a": int64(10), "b": int64(20)}},
+		{Script: `{v: k for k, v in {"a": 1, "b": 2} if v > 1}`, RunOutput: map[interface{}]interface{}{int64(2): "b"}},
+		{Script: `{x: x * x for x in [1, 2]}`, RunOutput: map[interface{}]interface{}{int64(1): int64(1), int64(2): int64(4)}},
+		{Script: `{x: true for x in ["a", "a"]}`, RunOutput: map[interface{}]interface{}{"a": true}},
+
+		{Script: `x = 1; a = [x for x in [2, 3]]; x`, RunOutput: int64(1), Output: map[string]interface{}{"x": int64(1), "a": []interface{}{int64(2), int64(3)}}},
+		{Script: `[x for x in [2, 3]]; x`, RunError: fmt.Errorf("undefined symbol 'x'")},
+		{Script: `y = 2; [x * y for x in [2, 3]]`, RunOutput: []interface{}{int64(4), int64(6)}},
+		{Script: `[[y for y in x] for x in [[1], [2, 3]]]`, RunOutput: []interface{}{[]interface{}{int64(1)}, []interface{}{int64(2), int64(3)}}},
+		{Script: `for i in [1, 2] { a = [i * x for x in [1, 2]] }; a`, RunError: fmt.Errorf("undefined symbol 'a'")},
+
+		{Script: `[x for x in 1]`, RunError: fmt.Errorf("for cannot loop over type int64")},
+		{Script: `[x for x in nil]`, RunError: fmt.Errorf("for cannot loop over type interface")},
+		{Script: `[y for x in [1]]`, RunError: fmt.Errorf("undefined symbol 'y'")},
+		{Script: `[x for x in [1] if y]`, RunError: fmt.Errorf("undefined symbol 'y'")},
+		{Script: `{y: x for x in [1]}`, RunError: fmt.Errorf("undefined symbol 'y'")},
+		{Script: `[x for in [1]]`, ParseError: fmt.Errorf("missing identifier"), RunError: fmt.Errorf("comprehension needs one or two identifiers")},
+		{Script: `[x for a, b, c in [1]]`, ParseError: fmt.Errorf("too many identifiers"), RunError: fmt.Errorf("comprehension needs one or two identifiers")},
+		{Script: `[x for x in [1], 2]`, ParseError: fmt.Errorf("syntax error")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestLabeledLoops(t *testing.T) {
+	tests := []Test{
+		{Script: `for { break a }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected break statement")},
+		{Script: `for { continue a }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected continue statement")},
+		{Script: `a: for false { }; for { break a }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected break statement")},
+		{Script: `a: for { break b }`, ParseError: fmt.Errorf("undefined label 'b'"), RunError: fmt.Errorf("unexpected break statement")},
+		{Script: `a: b = 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a: for { func() { break a }() }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected break statement")},
+		{Script: `a: for { b = func() { for { continue a } }; b() }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected continue statement")},
+		{Script: `a: for { b = () => { break a }; b() }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected break statement")},
+		{Script: `a: for { b = c => { for { break a } }; b(1) }`, ParseError: fmt.Errorf("undefined label 'a'"), RunError: fmt.Errorf("unexpected break statement")},
+
+		{Script: `a: for { break a }`, RunOutput: nil},
+		{Script: `a: for { break }`, RunOutput: nil},
+		{Script: `a:
+for { break a }`, RunOutput: nil},
+		{Script: `a = 0; b: for { for { a++; break b } }; a`, RunOutput: int64(1)},
+		{Script: `a = 0; b: for { c: for { a++; if a > 2 { break b }; continue c } }; a`, RunOutput: int64(3)},
+		{Script: `a = 0; b: for { for { a++; if a > 2 { break b }; continue b } }; a`, RunOutput: int64(3)},
+		{Script: `a = 0; b: for a < 3 { for { a++; continue b } }; a`, RunOutput: int64(3)},
+		{Script: `a = 0; b: for { b: for { a++; break b }; a++; break b }; a`, RunOutput: int64(2)},
+
+		{Script: `a = []; b: for i in [1, 2, 3] { for j in [1, 2, 3] { if j == 2 { continue b }; a += i * 10 + j } }; a`, RunOutput: []interface{}{int64(11), int64(21), int64(31)}},
+		{Script: `a = []; b: for i in [1, 2, 3] { for j in [1, 2, 3] { if i == 2 { break b }; a += i * 10 + j } }; a`, RunOutput: []interface{}{int64(11), int64(12), int64(13)}},
+		{Script: `a = 0; b: for k, v in {"c": 1} { for { a = v; break b } }; a`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64, 2); a <- 1; a <- 2; b = 0; c: for i in a { for { b += i; if i == 2 { break c }; continue c } }; b`, RunOutput: int64(3)},
+
+		{Script: `a = []; b: for i = 0; i < 3; i++ { for j = 0; j < 3; j++ { if j == 1 { continue b }; a += [[i, j]] } }; a`, RunOutput: []interface{}{[]interface{}{int64(0), int64(0)}, []interface{}{int64(1), int64(0)}, []interface{}{int64(2), int64(0)}}},
+		{Script: `a = []; b: for i = 0; i < 3; i++ { for j = 0; j < 3; j++ { if i == 1 { break b }; a += [[i, j]] } }; a`, RunOutput: []interface{}{[]interface{}{int64(0), int64(0)}, []interface{}{int64(0), int64(1)}, []interface{}{int64(0), int64(2)}}},
+		{Script: `a = 0; b: for { switch a { case 0: a++; continue b; default: break b } }; a`, RunOutput: int64(1)},
+		{Script: `func a() { b: for { for { return 1 } } }; a()`, RunOutput: int64(1)},
+		{Script: `a = 0; b: for { c = func() { b: for { break b }; return 1 }; a += c(); break b }; a`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestItemInList(t *testing.T) {
 	tests := []Test{
 		{Script: `"a" in ["a"]`, RunOutput: true},
@@ -1044,3 +1536,36 @@ func TestTry(t *testing.T) {
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestTypedCatch(t *testing.T) {
+	isEOF := func(t *testing.T, err error) {
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("Run error - received: %v - expected: %v", err, io.EOF)
+		}
+	}
+	tests := []Test{
+		{Script: `try { throw "a" } catch a { return a.Value }`, RunOutput: "a"},
+		{Script: `try { throw {"b": 1} } catch a { return a.Value.b }`, RunOutput: int64(1)},
+		{Script: `try { 1++ } catch a { return a.Value }`, RunOutput: nil},
+		{Script: `throw {"b": 1}`, RunError: fmt.Errorf("map[b:1]"), RunOutput: map[interface{}]interface{}{"b": int64(1)}},
+		{Script: `throw a`, Input: map[string]interface{}{"a": io.EOF}, RunErrorFunc: &isEOF, RunOutput: io.EOF},
+		{Script: `try { throw a } catch b { throw b }`, Input: map[string]interface{}{"a": io.EOF}, RunErrorFunc: &isEOF, RunOutput: io.EOF},
+		{Script: `try { 1++ } catch b { throw b }`, RunError: fmt.Errorf("invalid operation"), RunOutput: fmt.Errorf("invalid operation")},
+
+		{Script: `try { throw "a" } catch (b: string) { return b + "c" }`, RunOutput: "ac", Types: map[string]interface{}{"string": ""}},
+		{Script: `try { throw 1 } catch (b: string) { return 1 } catch (b: int64) { return b + 1 }`, RunOutput: int64(2), Types: map[string]interface{}{"string": "", "int64": int64(0)}},
+		{Script: `try { throw 1.5 } catch (b: string) { return 1 } catch (b) { return b.Value }`, RunOutput: 1.5, Types: map[string]interface{}{"string": ""}},
+		{Script: `try { throw 1.5 } catch (b: string) { return 1 }`, RunError: fmt.Errorf("1.5"), RunOutput: 1.5, Types: map[string]interface{}{"string": ""}},
+		{Script: `try { throw 1.5 } catch (b: string) { return 1 } finally { return 2 }`, RunError: fmt.Errorf("1.5"), RunOutput: 1.5, Types: map[string]interface{}{"string": ""}},
+		{Script: `try { throw "a" } catch (b: string) { } finally { return b }`, RunOutput: "a", Types: map[string]interface{}{"string": ""}},
+		{Script: `a = 1; try { throw "x" } catch (e: int64) { } finally { a = 2 }`, RunError: fmt.Errorf("x"), RunOutput: "x", Output: map[string]interface{}{"a": int64(2)}, Types: map[string]interface{}{"int64": int64(0)}},
+		{Script: `try { throw "x" } catch (e: int64) { } finally { throw "y" }`, RunError: fmt.Errorf("y"), RunOutput: "y", Types: map[string]interface{}{"int64": int64(0)}},
+		{Script: `func a() { try { throw "x" } catch (e: int64) { } finally { return 1 } }; a()`, RunError: fmt.Errorf("x"), Types: map[string]interface{}{"int64": int64(0)}},
+		{Script: `try { 1++ } catch (b: error) { return b.Error() }`, RunOutput: "invalid operation", Types: map[string]interface{}{"error": errorType}},
+		{Script: `try { throw a } catch (b: error) { return b }`, Input: map[string]interface{}{"a": io.EOF}, RunOutput: io.EOF, Types: map[string]interface{}{"error": errorType}},
+		{Script: `try { throw 1 } catch (b: c) { }`, RunError: fmt.Errorf("undefined type 'c'")},
+		{Script: `make(type a, struct { Code int64 }); try { b = make(a); b.Code = 2; throw b } catch (c: a) { return c.Code }`, RunOutput: int64(2)},
+		{Script: `try { throw 1 } catch (b: string) { return 1 } catch {}`, ParseError: fmt.Errorf("syntax error")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/gbl08ma/anko/ast"
 	"github.com/gbl08ma/anko/env"
@@ -41,13 +42,63 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	if runInfo.options == nil {
 		runInfo.options = &Options{}
 	}
+	// the limits are in the context so script functions defined in other runs are limited by this run
+	runInfo.limits = newRunLimits(runInfo.options)
+	if runInfo.limits != nil {
+		runInfo.ctx = context.WithValue(runInfo.ctx, runLimitsKey{}, runInfo.limits)
+	}
+	runInfo.ctx = withCallDepth(runInfo.ctx, runInfo.options)
+	var cancel context.CancelFunc
+	if runInfo.options.Goroutines == GoroutinesCancel {
+		runInfo.ctx, cancel = context.WithCancel(runInfo.ctx)
+		defer cancel()
+	}
+	runInfo.goroutines = newGoroutineGroup(runInfo.options)
+	runInfo.ctx = context.WithValue(runInfo.ctx, goroutineGroupKey{}, runInfo.goroutines)
+	if !runInfo.options.Strict && hasStrictPragma(stmt) {
+		options := *runInfo.options
+		options.Strict = true
+		runInfo.options = &options
+	}
 	runInfo.runSingleStmt()
+	runInfo.runDeferredCalls()
 	if runInfo.err == ErrReturn {
 		runInfo.err = nil
 	}
+	if runInfo.options.Goroutines == GoroutinesCancel {
+		cancel()
+	}
+	if runInfo.options.Goroutines != GoroutinesDetach {
+		if err := runInfo.goroutines.wait(); runInfo.err == nil {
+			runInfo.err = err
+		}
+	}
+	if runInfo.options.Steps != nil {
+		*runInfo.options.Steps = runInfo.limits.stepsUsed()
+	}
 	return runInfo.rv.Interface(), runInfo.err
 }
 
+// hasStrictPragma returns true if the first statement is the string "use strict"
+func hasStrictPragma(stmt ast.Stmt) bool {
+	stmts, ok := stmt.(*ast.StmtsStmt)
+	if ok {
+		if len(stmts.Stmts) < 1 {
+			return false
+		}
+		stmt = stmts.Stmts[0]
+	}
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	literalExpr, ok := exprStmt.Expr.(*ast.LiteralExpr)
+	if !ok {
+		return false
+	}
+	return literalExpr.Literal.Kind() == reflect.String && literalExpr.Literal.String() == "use strict"
+}
+
 // runSingleStmt executes statement in the specified environment with context.
 func (runInfo *runInfoStruct) runSingleStmt() {
 	select {
@@ -58,6 +109,12 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	default:
 	}
 
+	if runInfo.limits != nil && !runInfo.limits.step() {
+		runInfo.err = newError(runInfo.stmt, ErrStepLimit)
+		runInfo.rv = nilValue
+		return
+	}
+
 	switch stmt := runInfo.stmt.(type) {
 
 	// nil
@@ -66,12 +123,18 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	// StmtsStmt
 	case *ast.StmtsStmt:
 		for _, stmt := range stmt.Stmts {
-			switch stmt.(type) {
+			switch jumpStmt := stmt.(type) {
 			case *ast.BreakStmt:
 				runInfo.err = ErrBreak
+				runInfo.label = jumpStmt.Label
 				return
 			case *ast.ContinueStmt:
 				runInfo.err = ErrContinue
+				runInfo.label = jumpStmt.Label
+				return
+			case *ast.FallthroughStmt:
+				runInfo.rv = nilValue
+				runInfo.err = ErrFallthrough
 				return
 			case *ast.ReturnStmt:
 				runInfo.stmt = stmt
@@ -97,41 +160,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 	// VarStmt
 	case *ast.VarStmt:
-		// get right side expression values
-		rvs := make([]reflect.Value, len(stmt.Exprs))
-		var i int
-		for i, runInfo.expr = range stmt.Exprs {
-			runInfo.invokeExpr()
-			if runInfo.err != nil {
-				return
-			}
-			rvs[i] = runInfo.rv
-		}
-
-		if len(rvs) == 1 && len(stmt.Names) > 1 {
-			// only one right side value but many left side names
-			value := rvs[0]
-			if value.Kind() == reflect.Interface && !value.IsNil() {
-				value = value.Elem()
-			}
-			if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
-				// value is slice/array, add each value to left side names
-				for i := 0; i < value.Len() && i < len(stmt.Names); i++ {
-					runInfo.env.DefineValue(stmt.Names[i], value.Index(i))
-				}
-				// return last value of slice/array
-				runInfo.rv = value.Index(value.Len() - 1)
-				return
-			}
-		}
+		runInfo.defineNames(stmt, stmt.Names, stmt.Exprs, runInfo.env.DefineValue)
 
-		// define all names with right side values
-		for i = 0; i < len(rvs) && i < len(stmt.Names); i++ {
-			runInfo.env.DefineValue(stmt.Names[i], rvs[i])
-		}
-
-		// return last right side value
-		runInfo.rv = rvs[len(rvs)-1]
+	// ConstStmt
+	case *ast.ConstStmt:
+		runInfo.defineNames(stmt, stmt.Names, stmt.Exprs, runInfo.env.DefineConstValue)
 
 	// LetsStmt
 	case *ast.LetsStmt:
@@ -279,18 +312,32 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		runInfo.runSingleStmt()
 
 		if runInfo.err != nil {
-			if runInfo.err == ErrInterrupt {
+			if runInfo.err == ErrInterrupt || isRunLimitError(runInfo.err) {
 				runInfo.env = env
 				return
 			}
 
 			// Catch
-			runInfo.stmt = stmt.Catch
-			if stmt.Var != "" {
-				runInfo.env.DefineValue(stmt.Var, reflect.ValueOf(runInfo.err))
+			if len(stmt.Catches) > 0 {
+				if !runInfo.runCatchStmts(stmt.Catches) && stmt.Finally != nil {
+					// no catch clause matches, the error is returned after running finally
+					// only an error of finally replaces it, not a return, break or continue
+					err, rv := runInfo.err, runInfo.rv
+					runInfo.err = nil
+					runInfo.stmt = stmt.Finally
+					runInfo.runSingleStmt()
+					if runInfo.err == nil || runInfo.err == ErrReturn || runInfo.err == ErrBreak || runInfo.err == ErrContinue {
+						runInfo.err, runInfo.rv = err, rv
+					}
+				}
+			} else {
+				runInfo.stmt = stmt.Catch
+				if stmt.Var != "" {
+					runInfo.env.DefineValue(stmt.Var, reflect.ValueOf(runInfo.err))
+				}
+				runInfo.err = nil
+				runInfo.runSingleStmt()
 			}
-			runInfo.err = nil
-			runInfo.runSingleStmt()
 			if runInfo.err != nil {
 				runInfo.env = env
 				return
@@ -334,7 +381,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			runInfo.stmt = stmt.Stmt
 			runInfo.runSingleStmt()
 			if runInfo.err != nil {
-				if runInfo.err == ErrContinue {
+				if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
 					runInfo.err = nil
 					continue
 				}
@@ -342,7 +389,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					runInfo.env = env
 					return
 				}
-				if runInfo.err == ErrBreak {
+				if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
 					runInfo.err = nil
 				}
 				break
@@ -352,6 +399,10 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		runInfo.rv = nilValue
 		runInfo.env = env
 
+	// comprehensionStmt
+	case *comprehensionStmt:
+		runInfo.runComprehensionStmt(stmt)
+
 	// ForStmt
 	case *ast.ForStmt:
 		runInfo.expr = stmt.Value
@@ -391,7 +442,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.stmt = stmt.Stmt
 				runInfo.runSingleStmt()
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
+					if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 						continue
 					}
@@ -399,7 +450,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
+					if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 					}
 					break
@@ -429,7 +480,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.stmt = stmt.Stmt
 				runInfo.runSingleStmt()
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
+					if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 						continue
 					}
@@ -437,7 +488,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
+					if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 					}
 					break
@@ -479,7 +530,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.stmt = stmt.Stmt
 				runInfo.runSingleStmt()
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
+					if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 						continue
 					}
@@ -487,7 +538,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
+					if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
 						runInfo.err = nil
 					}
 					break
@@ -496,7 +547,24 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			runInfo.rv = nilValue
 			runInfo.env = env
 
+		case reflect.Func:
+			runInfo.rangeFunc(stmt, value)
+			runInfo.env = env
+
 		default:
+			if all := allMethod(value); all.IsValid() {
+				var iterator reflect.Value
+				iterator, runInfo.err = runInfo.callAll(all)
+				if runInfo.err != nil {
+					runInfo.err = newError(stmt, runInfo.err)
+					runInfo.rv = nilValue
+					runInfo.env = env
+					return
+				}
+				runInfo.rangeFunc(stmt, iterator)
+				runInfo.env = env
+				return
+			}
 			runInfo.err = newStringError(stmt, "for cannot loop over type "+value.Kind().String())
 			runInfo.rv = nilValue
 			runInfo.env = env
@@ -539,7 +607,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 			runInfo.stmt = stmt.Stmt
 			runInfo.runSingleStmt()
-			if runInfo.err == ErrContinue {
+			if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
 				runInfo.err = nil
 			}
 			if runInfo.err != nil {
@@ -547,7 +615,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					runInfo.env = env
 					return
 				}
-				if runInfo.err == ErrBreak {
+				if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
 					runInfo.err = nil
 				}
 				break
@@ -593,7 +661,13 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		if runInfo.err != nil {
 			return
 		}
-		runInfo.err = newStringError(stmt, fmt.Sprint(runInfo.rv.Interface()))
+		value := runInfo.rv.Interface()
+		if err, ok := value.(*Error); ok {
+			// rethrow keeps the original error
+			runInfo.err = err
+			return
+		}
+		runInfo.err = &Error{Message: fmt.Sprint(value), Pos: stmt.Position(), Value: value}
 
 	// ModuleStmt
 	case *ast.ModuleStmt:
@@ -615,15 +689,18 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		env := runInfo.env
 		runInfo.env = env.NewEnv()
 
-		runInfo.expr = stmt.Expr
-		runInfo.invokeExpr()
-		if runInfo.err != nil {
-			runInfo.env = env
-			return
+		var value reflect.Value
+		if stmt.Expr != nil {
+			runInfo.expr = stmt.Expr
+			runInfo.invokeExpr()
+			if runInfo.err != nil {
+				runInfo.env = env
+				return
+			}
+			value = runInfo.rv
 		}
-		value := runInfo.rv
 
-		for _, switchCaseStmt := range stmt.Cases {
+		for i, switchCaseStmt := range stmt.Cases {
 			caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
 			for _, runInfo.expr = range caseStmt.Exprs {
 				runInfo.invokeExpr()
@@ -631,7 +708,72 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					runInfo.env = env
 					return
 				}
-				if equal(runInfo.rv, value) {
+				var match bool
+				switch {
+				case stmt.Expr == nil:
+					match = toBool(runInfo.rv)
+				case runInfo.options.StrictSwitch:
+					match, runInfo.err = runInfo.strictEqual(runInfo.expr.Position(), value, runInfo.rv)
+				default:
+					match, runInfo.err = runInfo.equalWithMethods(runInfo.expr.Position(), value, runInfo.rv)
+				}
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					runInfo.env = env
+					return
+				}
+				if match {
+					runInfo.runSwitchCases(stmt, i)
+					runInfo.env = env
+					return
+				}
+			}
+		}
+
+		if stmt.Default == nil {
+			runInfo.rv = nilValue
+		} else {
+			runInfo.stmt = stmt.Default
+			runInfo.runSingleStmt()
+		}
+
+		runInfo.env = env
+
+	// TypeSwitchStmt
+	case *ast.TypeSwitchStmt:
+		env := runInfo.env
+		runInfo.env = env.NewEnv()
+
+		runInfo.expr = stmt.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			runInfo.env = env
+			return
+		}
+		value := runInfo.rv
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		if stmt.Var != "" {
+			runInfo.env.DefineValue(stmt.Var, value)
+		}
+
+		for _, typeSwitchCaseStmt := range stmt.Cases {
+			caseStmt := typeSwitchCaseStmt.(*ast.TypeSwitchCaseStmt)
+			for _, typeData := range caseStmt.Types {
+				var match bool
+				if typeData == nil {
+					match = !value.IsValid() || isNil(value)
+				} else {
+					t := makeType(runInfo, typeData)
+					if runInfo.err != nil {
+						runInfo.rv = nilValue
+						runInfo.env = env
+						return
+					}
+					match = t != nil && isType(value, t)
+				}
+				if match {
 					runInfo.stmt = caseStmt.Stmt
 					runInfo.runSingleStmt()
 					runInfo.env = env
@@ -649,11 +791,103 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		runInfo.env = env
 
+	// SelectStmt
+	case *ast.SelectStmt:
+		env := runInfo.env
+		runInfo.env = env.NewEnv()
+
+		// first case is always context done so interrupts still work
+		cases := make([]reflect.SelectCase, 1, len(stmt.Cases)+2)
+		cases[0] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(runInfo.ctx.Done()),
+		}
+		for _, selectCaseStmt := range stmt.Cases {
+			caseStmt := selectCaseStmt.(*ast.SelectCaseStmt)
+			cases = append(cases, runInfo.makeSelectCase(caseStmt))
+			if runInfo.err != nil {
+				runInfo.env = env
+				return
+			}
+		}
+		if stmt.Default != nil {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+		}
+
+		var chosen int
+		var ok bool
+		func() {
+			// capture panics if not in debug mode
+			defer func() {
+				if !runInfo.options.Debug {
+					if recoverResult := recover(); recoverResult != nil {
+						runInfo.err = newStringError(stmt, fmt.Sprint(recoverResult))
+					}
+				}
+			}()
+			chosen, runInfo.rv, ok = reflect.Select(cases)
+		}()
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			runInfo.env = env
+			return
+		}
+		if chosen == 0 {
+			runInfo.err = ErrInterrupt
+			runInfo.rv = nilValue
+			runInfo.env = env
+			return
+		}
+
+		if chosen > len(stmt.Cases) {
+			// default
+			runInfo.rv = nilValue
+			runInfo.stmt = stmt.Default
+			runInfo.runSingleStmt()
+			runInfo.env = env
+			return
+		}
+
+		caseStmt := stmt.Cases[chosen-1].(*ast.SelectCaseStmt)
+		if cases[chosen].Dir == reflect.SelectRecv {
+			if !ok {
+				runInfo.rv = nilValue
+			} else if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+				runInfo.rv = runInfo.rv.Elem()
+			}
+			rvs := []reflect.Value{runInfo.rv, reflect.ValueOf(ok)}
+			chanExpr := caseStmt.Expr.(*ast.ChanExpr)
+			if chanExpr.LHS != nil {
+				// receive into left side of chan expression, same as ChanExpr does
+				runInfo.expr = chanExpr.LHS
+				runInfo.invokeLetExpr()
+			}
+			for i := 0; i < len(caseStmt.LHSS) && i < len(rvs) && runInfo.err == nil; i++ {
+				runInfo.rv = rvs[i]
+				runInfo.expr = caseStmt.LHSS[i]
+				runInfo.invokeLetExpr()
+			}
+			if runInfo.err != nil {
+				runInfo.env = env
+				return
+			}
+		}
+
+		runInfo.rv = nilValue
+		runInfo.stmt = caseStmt.Stmt
+		runInfo.runSingleStmt()
+		runInfo.env = env
+
 	// GoroutineStmt
 	case *ast.GoroutineStmt:
 		runInfo.expr = stmt.Expr
 		runInfo.invokeExpr()
 
+	// DeferStmt
+	case *ast.DeferStmt:
+		runInfo.expr = stmt.Expr
+		runInfo.invokeExpr()
+
 	// DeleteStmt
 	case *ast.DeleteStmt:
 		runInfo.expr = stmt.Item
@@ -677,12 +911,15 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		switch item.Kind() {
 		case reflect.String:
+			var err error
 			if stmt.Key != nil && runInfo.rv.Kind() == reflect.Bool && runInfo.rv.Bool() {
-				runInfo.env.DeleteGlobal(item.String())
-				runInfo.rv = nilValue
-				return
+				err = runInfo.env.DeleteGlobalChecked(item.String())
+			} else {
+				err = runInfo.env.DeleteChecked(item.String())
+			}
+			if err != nil {
+				runInfo.err = newError(stmt, err)
 			}
-			runInfo.env.Delete(item.String())
 			runInfo.rv = nilValue
 
 		case reflect.Map:
@@ -730,3 +967,323 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	}
 
 }
+
+// makeSelectCase evaluates the chan expression of a select case and returns the reflect.SelectCase for it.
+// Like ChanExpr, if the left side is a channel it is a send, otherwise it is a receive from the right side.
+func (runInfo *runInfoStruct) makeSelectCase(caseStmt *ast.SelectCaseStmt) reflect.SelectCase {
+	chanExpr, ok := caseStmt.Expr.(*ast.ChanExpr)
+	if !ok || (len(caseStmt.LHSS) > 0 && chanExpr.LHS != nil) {
+		runInfo.err = newStringError(caseStmt, "select case must be receive or send")
+		runInfo.rv = nilValue
+		return reflect.SelectCase{}
+	}
+
+	runInfo.expr = chanExpr.RHS
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return reflect.SelectCase{}
+	}
+	if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+		runInfo.rv = runInfo.rv.Elem()
+	}
+	rhs := runInfo.rv
+
+	if chanExpr.LHS != nil {
+		runInfo.expr = chanExpr.LHS
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			if len(runInfo.err.Error()) < 18 || runInfo.err.Error()[:17] != "undefined symbol " {
+				return reflect.SelectCase{}
+			}
+			runInfo.err = nil
+		}
+		if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+			runInfo.rv = runInfo.rv.Elem()
+		}
+
+		if runInfo.rv.Kind() == reflect.Chan {
+			// send to lhs channel
+			rhs, runInfo.err = convertReflectValueToType(rhs, runInfo.rv.Type().Elem())
+			if runInfo.err != nil {
+				runInfo.err = newStringError(chanExpr, "cannot use type "+rhs.Type().String()+" as type "+runInfo.rv.Type().Elem().String()+" to send to chan")
+				runInfo.rv = nilValue
+				return reflect.SelectCase{}
+			}
+			return reflect.SelectCase{Dir: reflect.SelectSend, Chan: runInfo.rv, Send: rhs}
+		}
+	}
+
+	// receive from rhs channel
+	if rhs.Kind() != reflect.Chan {
+		runInfo.err = newStringError(chanExpr, "invalid operation for chan")
+		runInfo.rv = nilValue
+		return reflect.SelectCase{}
+	}
+	return reflect.SelectCase{Dir: reflect.SelectRecv, Chan: rhs}
+}
+
+// isLoopLabel returns true if the break or continue is for the loop with label.
+// A break or continue without a label is for the innermost loop.
+func (runInfo *runInfoStruct) isLoopLabel(label string) bool {
+	if runInfo.label == "" {
+		return true
+	}
+	if runInfo.label != label {
+		return false
+	}
+	runInfo.label = ""
+	return true
+}
+
+// runSwitchCases runs the switch case at index.
+// While a case ends with a fallthrough statement the next case is run, the default case being the last one.
+func (runInfo *runInfoStruct) runSwitchCases(stmt *ast.SwitchStmt, index int) {
+	for ; index < len(stmt.Cases); index++ {
+		runInfo.stmt = stmt.Cases[index].(*ast.SwitchCaseStmt).Stmt
+		runInfo.runSingleStmt()
+		if runInfo.err != ErrFallthrough {
+			return
+		}
+		runInfo.err = nil
+	}
+	if stmt.Default == nil {
+		runInfo.err = ErrFallthrough
+		return
+	}
+	runInfo.stmt = stmt.Default
+	runInfo.runSingleStmt()
+}
+
+// runCatchStmts runs the first catch clause matching runInfo.err.
+// A typed clause matches the thrown value, or the error itself when it was not thrown by the script,
+// and its variable is set to that value. A clause without a type matches everything and its variable is set to the error.
+// When no clause matches runInfo.err is left as is and false is returned.
+func (runInfo *runInfoStruct) runCatchStmts(catches []ast.Stmt) bool {
+	err := runInfo.err
+	value := reflect.ValueOf(err)
+	if vmErr, ok := err.(*Error); ok && vmErr.Value != nil {
+		value = reflect.ValueOf(vmErr.Value)
+	}
+
+	for _, catch := range catches {
+		catchStmt := catch.(*ast.CatchStmt)
+		if catchStmt.Type == nil {
+			runInfo.env.DefineValue(catchStmt.Var, reflect.ValueOf(err))
+		} else {
+			runInfo.err = nil
+			t := makeType(runInfo, catchStmt.Type)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return true
+			}
+			runInfo.err = err
+			if t == nil || !isType(value, t) {
+				continue
+			}
+			runInfo.env.DefineValue(catchStmt.Var, value)
+		}
+		runInfo.stmt = catchStmt.Stmt
+		runInfo.err = nil
+		runInfo.runSingleStmt()
+		return true
+	}
+	return false
+}
+
+// allMethod returns the All method of the value if it has one without arguments that returns a function,
+// like the iter.Seq returned by the All method of many collection types, otherwise an invalid value.
+func allMethod(value reflect.Value) reflect.Value {
+	if !value.IsValid() {
+		return reflect.Value{}
+	}
+	method := value.MethodByName("All")
+	if !method.IsValid() {
+		return reflect.Value{}
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 || methodType.Out(0).Kind() != reflect.Func {
+		return reflect.Value{}
+	}
+	return method
+}
+
+// rangeFunc runs the for statement for each value yielded by an iterator function.
+// The iterator is either a Go func(yield func(V) bool) or func(yield func(K, V) bool),
+// or a script function that takes a yield function with one argument per for variable.
+func (runInfo *runInfoStruct) rangeFunc(stmt *ast.ForStmt, value reflect.Value) {
+	if value.IsNil() {
+		runInfo.err = newStringError(stmt, "for cannot loop over nil func")
+		runInfo.rv = nilValue
+		return
+	}
+
+	valueType := value.Type()
+	isRunVMFunction := checkIfRunVMFunction(valueType)
+	var funcYieldType reflect.Type
+	if isRunVMFunction {
+		if valueType.NumIn() != 2 || valueType.IsVariadic() {
+			runInfo.err = newStringError(stmt, "for cannot loop over func with "+strconv.Itoa(valueType.NumIn()-1)+" arguments")
+			runInfo.rv = nilValue
+			return
+		}
+		if len(stmt.Vars) > 1 {
+			funcYieldType = yield2Type
+		} else {
+			funcYieldType = yieldType
+		}
+	} else {
+		if valueType.NumIn() == 1 && valueType.NumOut() == 0 {
+			funcYieldType = valueType.In(0)
+		}
+		if funcYieldType == nil || funcYieldType.Kind() != reflect.Func || funcYieldType.NumIn() < 1 || funcYieldType.NumIn() > 2 ||
+			funcYieldType.IsVariadic() || funcYieldType.NumOut() != 1 || funcYieldType.Out(0).Kind() != reflect.Bool {
+			runInfo.err = newStringError(stmt, "for cannot loop over type "+valueType.String())
+			runInfo.rv = nilValue
+			return
+		}
+		if len(stmt.Vars) > funcYieldType.NumIn() {
+			runInfo.err = newStringError(stmt, "for has more variables than "+valueType.String()+" yields")
+			runInfo.rv = nilValue
+			return
+		}
+	}
+
+	// done is set when the loop has exited, after which the iterator must not call yield again
+	var done, continued bool
+	yield := reflect.MakeFunc(funcYieldType, func(in []reflect.Value) []reflect.Value {
+		if done {
+			continued = true
+			return []reflect.Value{falseValue}
+		}
+
+		select {
+		case <-runInfo.ctx.Done():
+			runInfo.err = ErrInterrupt
+			done = true
+			return []reflect.Value{falseValue}
+		default:
+		}
+
+		for i := 0; i < len(stmt.Vars); i++ {
+			iv := in[i]
+			if iv.Kind() == reflect.Interface && !iv.IsNil() {
+				iv = iv.Elem()
+			}
+			runInfo.env.DefineValue(stmt.Vars[i], iv)
+		}
+
+		runInfo.stmt = stmt.Stmt
+		runInfo.runSingleStmt()
+		if runInfo.err != nil {
+			if runInfo.err == ErrContinue && runInfo.isLoopLabel(stmt.Label) {
+				runInfo.err = nil
+				return []reflect.Value{trueValue}
+			}
+			if runInfo.err == ErrBreak && runInfo.isLoopLabel(stmt.Label) {
+				runInfo.err = nil
+			}
+			done = true
+			return []reflect.Value{falseValue}
+		}
+		return []reflect.Value{trueValue}
+	})
+
+	var args []reflect.Value
+	if isRunVMFunction {
+		args = []reflect.Value{reflect.ValueOf(runInfo.ctx), reflect.ValueOf(yield)}
+	} else {
+		args = []reflect.Value{yield}
+	}
+
+	err := runInfo.callIterator(value, args, isRunVMFunction)
+	switch {
+	case continued:
+		runInfo.err = newStringError(stmt, "for iterator continued after loop exit")
+	case runInfo.ctx.Err() != nil:
+		runInfo.err = ErrInterrupt
+	case runInfo.err == ErrReturn:
+		return
+	case runInfo.err == nil && err != nil:
+		runInfo.err = newError(stmt, err)
+	}
+	runInfo.rv = nilValue
+}
+
+// callIterator calls the iterator function of a for statement
+func (runInfo *runInfoStruct) callIterator(value reflect.Value, args []reflect.Value, isRunVMFunction bool) (err error) {
+	// capture panics if not in debug mode
+	defer func() {
+		if !runInfo.options.Debug {
+			if recoverResult := recover(); recoverResult != nil {
+				err = fmt.Errorf("%v", recoverResult)
+			}
+		}
+	}()
+
+	_, err = processCallReturnValues(value.Call(args), isRunVMFunction, true)
+	return err
+}
+
+// callAll calls the All method found by allMethod, returning the iterator function or the panic of the call
+func (runInfo *runInfoStruct) callAll(all reflect.Value) (iterator reflect.Value, err error) {
+	// capture panics if not in debug mode
+	defer func() {
+		if !runInfo.options.Debug {
+			if recoverResult := recover(); recoverResult != nil {
+				err = fmt.Errorf("%v", recoverResult)
+			}
+		}
+	}()
+
+	return all.Call(nil)[0], nil
+}
+
+// defineNames defines the names with the values of the expressions, using define for each name.
+// Used by var and const statements.
+func (runInfo *runInfoStruct) defineNames(stmt ast.Stmt, names []string, exprs []ast.Expr, define func(string, reflect.Value) error) {
+	// get right side expression values
+	rvs := make([]reflect.Value, len(exprs))
+	var i int
+	for i, runInfo.expr = range exprs {
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		rvs[i] = runInfo.rv
+	}
+
+	if len(rvs) == 1 && len(names) > 1 {
+		// only one right side value but many left side names
+		value := rvs[0]
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+		if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
+			// value is slice/array, add each value to left side names
+			for i := 0; i < value.Len() && i < len(names); i++ {
+				err := define(names[i], value.Index(i))
+				if err != nil {
+					runInfo.err = newError(stmt, err)
+					runInfo.rv = nilValue
+					return
+				}
+			}
+			// return last value of slice/array
+			runInfo.rv = value.Index(value.Len() - 1)
+			return
+		}
+	}
+
+	// define all names with right side values
+	for i = 0; i < len(rvs) && i < len(names); i++ {
+		err := define(names[i], rvs[i])
+		if err != nil {
+			runInfo.err = newError(stmt, err)
+			runInfo.rv = nilValue
+			return
+		}
+	}
+
+	// return last right side value
+	runInfo.rv = rvs[len(rvs)-1]
+}
@@ -3,6 +3,7 @@ package vm
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -13,6 +14,12 @@ func toString(v reflect.Value) string {
 	if v.Kind() == reflect.Interface && !v.IsNil() {
 		v = v.Elem()
 	}
+	if isBigNumber(v) {
+		if r, ok := v.Interface().(*big.Rat); ok {
+			return DecimalString(r)
+		}
+		return v.Interface().(*big.Int).String()
+	}
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
@@ -34,6 +41,9 @@ func toBool(v reflect.Value) bool {
 // with parseBool https://golang.org/pkg/strconv/#ParseBool
 // and is not 0.0
 func tryToBool(v reflect.Value) (bool, error) {
+	if isBigNumber(v) {
+		return toBigRat(v).Sign() != 0, nil
+	}
 	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		v = v.Elem()
 	}
@@ -77,6 +87,10 @@ func toFloat64(v reflect.Value) float64 {
 // If it cannot (in the case of a non-numeric string, a struct, etc.)
 // it returns 0.0 and an error.
 func tryToFloat64(v reflect.Value) (float64, error) {
+	if isBigNumber(v) {
+		f, _ := toBigRat(v).Float64()
+		return f, nil
+	}
 	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		v = v.Elem()
 	}
@@ -109,6 +123,13 @@ func toInt64(v reflect.Value) int64 {
 // If it cannot (in the case of a non-numeric string, a struct, etc.)
 // it returns 0 and an error.
 func tryToInt64(v reflect.Value) (int64, error) {
+	if isBigNumber(v) {
+		i := toBigInt(v)
+		if !i.IsInt64() {
+			return 0, errors.New("couldn't convert to integer")
+		}
+		return i.Int64(), nil
+	}
 	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		v = v.Elem()
 	}
@@ -148,6 +169,10 @@ func toInt(v reflect.Value) int {
 // If it cannot (in the case of a non-numeric string, a struct, etc.)
 // it returns 0 and an error.
 func tryToInt(v reflect.Value) (int, error) {
+	if isBigNumber(v) {
+		i, err := tryToInt64(v)
+		return int(i), err
+	}
 	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		v = v.Elem()
 	}
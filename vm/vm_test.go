@@ -2,16 +2,21 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/gbl08ma/anko/ast"
 	"github.com/gbl08ma/anko/env"
+	"github.com/gbl08ma/anko/parser"
 )
 
 func TestNumbers(t *testing.T) {
@@ -208,6 +213,50 @@ func TestStrings(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestInterpolatedStrings(t *testing.T) {
+	tests := []Test{
+		{Script: `"${}"`, ParseError: fmt.Errorf("string interpolation must be an expression"), RunOutput: ""},
+		{Script: `"${a = 1}"`, ParseError: fmt.Errorf("string interpolation must be an expression"), RunOutput: ""},
+		{Script: `"${1 +}"`, ParseError: fmt.Errorf("syntax error"), RunOutput: ""},
+		{Script: `"${1"`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `"${a}"`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `"${1++}"`, RunError: fmt.Errorf("invalid operation")},
+
+		{Script: `"${1}"`, RunOutput: "1"},
+		{Script: `"a${1}b"`, RunOutput: "a1b"},
+		{Script: `"${1}${2}"`, RunOutput: "12"},
+		{Script: `"a ${b} c"`, Input: map[string]interface{}{"b": "x"}, RunOutput: "a x c", Output: map[string]interface{}{"b": "x"}},
+		{Script: `"${b + 1}"`, Input: map[string]interface{}{"b": int64(1)}, RunOutput: "2", Output: map[string]interface{}{"b": int64(1)}},
+		{Script: `"${b}"`, Input: map[string]interface{}{"b": nil}, RunOutput: "<nil>", Output: map[string]interface{}{"b": nil}},
+		{Script: `"${b}"`, Input: map[string]interface{}{"b": true}, RunOutput: "true", Output: map[string]interface{}{"b": true}},
+		{Script: `"${b}"`, Input: map[string]interface{}{"b": float64(1.5)}, RunOutput: "1.5", Output: map[string]interface{}{"b": float64(1.5)}},
+		{Script: `"${b}"`, Input: map[string]interface{}{"b": []interface{}{int64(1), "c"}}, RunOutput: "[1 c]", Output: map[string]interface{}{"b": []interface{}{int64(1), "c"}}},
+		{Script: `"${b}"`, Input: map[string]interface{}{"b": time.Second}, RunOutput: "1s", Output: map[string]interface{}{"b": time.Second}},
+		{Script: `"${len(b)} items"`, Input: map[string]interface{}{"b": []interface{}{int64(1), int64(2)}}, RunOutput: "2 items", Output: map[string]interface{}{"b": []interface{}{int64(1), int64(2)}}},
+		{Script: `"${b["c"]}"`, Input: map[string]interface{}{"b": map[string]interface{}{"c": "d"}}, RunOutput: "d", Output: map[string]interface{}{"b": map[string]interface{}{"c": "d"}}},
+		{Script: `"${ {"b": 1}["b"] }"`, RunOutput: "1"},
+		{Script: `"a ${"b ${1} c"} d"`, RunOutput: "a b 1 c d"},
+		{Script: `"$"`, RunOutput: "$"},
+		{Script: `"$a"`, RunOutput: "$a"},
+		{Script: `"\${a}"`, RunOutput: "${a}"},
+		{Script: `'${a}'`, RunOutput: "${a}"},
+		{Script: "`${a}`", RunOutput: "${a}"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	_, err := Execute(env.NewEnv(), nil, "a = 1\nb = \"a ${c} d\"")
+	if err == nil {
+		t.Fatal("Execute error - received: nil - expected: undefined symbol 'c'")
+	}
+	vmError, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Execute error type - received: %T - expected: *Error", err)
+	}
+	if vmError.Pos.Line != 2 || vmError.Pos.Column != 10 {
+		t.Errorf("Execute error position - received: %v - expected: 2:10", vmError.Pos)
+	}
+}
+
 func TestVar(t *testing.T) {
 	testInput1 := map[string]interface{}{"b": func() {}}
 	tests := []Test{
@@ -216,7 +265,7 @@ func TestVar(t *testing.T) {
 		{Script: `var 1 = 2`, ParseError: fmt.Errorf("syntax error")},
 		{Script: `a = 1++`, RunError: fmt.Errorf("invalid operation")},
 		{Script: `var a = 1++`, RunError: fmt.Errorf("invalid operation")},
-		{Script: `a := 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a := 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
 		{Script: `var a := 1`, ParseError: fmt.Errorf("syntax error")},
 		{Script: `y = z`, RunError: fmt.Errorf("undefined symbol 'z'")},
 
@@ -392,6 +441,131 @@ a  =  1;
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestConst(t *testing.T) {
+	tests := []Test{
+		{Script: `const 1 = 2`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `const a`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `const a = 1++`, RunError: fmt.Errorf("invalid operation")},
+
+		{Script: `const a = 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = "a"; a + "b"`, RunOutput: "ab", Output: map[string]interface{}{"a": "a"}},
+		{Script: `const a, b = 1, 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `const a, b = [1, 2]`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = 1; const a = 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+
+		{Script: `const a = 1; a = 2`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a++`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a += 1`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; a, b = 2, 3`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; var a = 2`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; const a = 2`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func a() {}`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; delete("a")`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; delete("a", true)`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { a = 2 }; b()`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; func b() { delete("a", true) }; b()`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+
+		{Script: `const a = 1; func b() { var a = 2; return a }; b()`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `const a = 1; for a in [2] { }; a`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `func b() { const a = 1; return a }; b(); a = 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `const a = [1]; a[0] = 2; a`, RunOutput: []interface{}{int64(2)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	e := env.NewEnv()
+	err := e.DefineConst("a", int64(1))
+	if err != nil {
+		t.Fatalf("DefineConst error - received: %v - expected: %v", err, nil)
+	}
+	_, err = Execute(e, nil, "b = a + 1\na = b")
+	if err == nil || err.Error() != "cannot change constant 'a'" {
+		t.Fatalf("Execute error - received: %v - expected: %v", err, "cannot change constant 'a'")
+	}
+	vmError, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Execute error type - received: %T - expected: *Error", err)
+	}
+	if vmError.Pos.Line != 2 || vmError.Pos.Column != 1 {
+		t.Errorf("Execute error position - received: %v - expected: 2:1", vmError.Pos)
+	}
+	value, err := e.Get("a")
+	if err != nil {
+		t.Fatalf("Get error - received: %v - expected: %v", err, nil)
+	}
+	if value != int64(1) {
+		t.Errorf("Get value - received: %#v - expected: %#v", value, int64(1))
+	}
+}
+
+func TestShortVarDeclaration(t *testing.T) {
+	tests := []Test{
+		{Script: `1 := 2`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a, 1 := 1, 2`, ParseError: fmt.Errorf("syntax error: unexpected ':='"), RunOutput: int64(2)},
+		{Script: `a.b, c := 1, 2`, ParseError: fmt.Errorf("syntax error: unexpected ':='"), RunOutput: int64(2)},
+		{Script: `a := 1++`, RunError: fmt.Errorf("invalid operation")},
+
+		{Script: `a, b := 1, 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a, b, c := 1, 2, 3; c`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(1), "b": int64(2), "c": int64(3)}},
+		{Script: `func a() { return 1, 2 }; b, c := a()`, RunOutput: int64(2), Output: map[string]interface{}{"b": int64(1), "c": int64(2)}},
+		{Script: `a := 1; b, ok := a.(int64)`, RunOutput: true, Output: map[string]interface{}{"b": int64(1), "ok": true}},
+		{Script: `a := 1; b, ok := a.(string)`, RunOutput: false, Output: map[string]interface{}{"b": "", "ok": false}},
+		{Script: `a, b := 1, 2; func c() { a, b := 3, 4 }; c(); [a, b]`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a, b := 1,
+	2; b`, RunOutput: int64(2)},
+
+		{Script: `a := 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a := 1; a := "a"; a`, RunOutput: "a", Output: map[string]interface{}{"a": "a"}},
+		{Script: `a := 1; func b() { a := 2 }; b(); a`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a = 0; for b := 0; b < 3; b++ { a += b }; a`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(3)}},
+		{Script: `const a = 1; a := 2`, RunError: fmt.Errorf("cannot change constant 'a'"), Output: map[string]interface{}{"a": int64(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestStrict(t *testing.T) {
+	tests := []Test{
+		{Script: `a = 1`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `a, b = 1, 2`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `var a = 1; a, b = 1, 2`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `a, b = c["d"]`, Input: map[string]interface{}{"c": map[string]interface{}{"d": int64(1)}}, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `func a() { b = 1 }; a()`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `for a = 0; a < 2; a++ {}`, RunError: fmt.Errorf("undefined symbol 'a'")},
+
+		{Script: `a = 2`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `var a = 1; a = 2`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `a := 1; a = 2; a++`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(3)}},
+		{Script: `var a = 1; func b() { a = 2 }; b(); a`, RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `var a = 0; for b := 0; b < 3; b++ { a += b }; a`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(3)}},
+		{Script: `var a = 0; for b in [1, 2] { a += b }; a`, RunOutput: int64(3), Output: map[string]interface{}{"a": int64(3)}},
+		{Script: `var a = {}; a["b"] = 1; a.c = 2; a`, RunOutput: map[interface{}]interface{}{"b": int64(1), "c": int64(2)}},
+		{Script: `func a() { return 1 }; a()`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Strict: true})
+
+	tests = []Test{
+		{Script: `"use strict"`, RunOutput: "use strict"},
+		{Script: `"use strict"; a = 1`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: "\"use strict\"\nvar a = 1\na = 2", RunOutput: int64(2), Output: map[string]interface{}{"a": int64(2)}},
+		{Script: `"use strict"; func a() { b = 1 }; a()`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `'use strict'; a = 1`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `1; "use strict"; a = 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `a = "use strict"; b = 1`, RunOutput: int64(1), Output: map[string]interface{}{"a": "use strict", "b": int64(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	_, err := Execute(env.NewEnv(), nil, "\"use strict\"\nvar total = 1\ntotl = total + 1")
+	if err == nil || err.Error() != "undefined symbol 'totl'" {
+		t.Fatalf("Execute error - received: %v - expected: %v", err, "undefined symbol 'totl'")
+	}
+	vmError, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Execute error type - received: %T - expected: *Error", err)
+	}
+	if vmError.Pos.Line != 3 || vmError.Pos.Column != 1 {
+		t.Errorf("Execute error position - received: %v - expected: 3:1", vmError.Pos)
+	}
+}
+
 func TestModule(t *testing.T) {
 	tests := []Test{
 		{Script: `module a.b { }`, ParseError: fmt.Errorf("syntax error")},
@@ -409,6 +583,11 @@ func TestModule(t *testing.T) {
 
 		{Script: `module a { _b = "b"; func c() { return _b} }`, RunOutput: nil},
 		{Script: `module a { _b = "b"; func c() { return _b} }; a.c()`, RunOutput: "b"},
+		{Script: `module a { _b = "b" }; a._b`, RunError: fmt.Errorf("cannot refer to private symbol '_b'")},
+		{Script: `module a { func _b() { return "b" } }; a._b()`, RunError: fmt.Errorf("cannot refer to private symbol '_b'")},
+		{Script: `module a { func _b() { return "b" }; func c() { return _b() } }; a.c()`, RunOutput: "b"},
+		{Script: `module a { _b = 1; _b++; c = _b }; a.c`, RunOutput: int64(2)},
+		{Script: `module a { _b = 1 }; a?._b`, RunError: fmt.Errorf("cannot refer to private symbol '_b'")},
 
 		// test type scope
 		{Script: `module b { make(type Duration, a) }; func c() { d = new(time.Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunError: fmt.Errorf("no namespace called: time")},
@@ -416,6 +595,9 @@ func TestModule(t *testing.T) {
 		{Script: `module x { module time { make(type Duration, a) } }; func c() { d = new(x.time.Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunOutput: time.Duration(0)},
 		{Script: `module x { module time { make(type Duration, a) } }; func c() { d = new(y.time.Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunError: fmt.Errorf("no namespace called: y")},
 		{Script: `module x { module time { make(type Duration, a) } }; func c() { d = new(x.y.Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunError: fmt.Errorf("no namespace called: y")},
+		{Script: `module x { module _time { make(type Duration, a) } }; func c() { d = new(x._time.Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunError: fmt.Errorf("cannot refer to private symbol '_time'")},
+		{Script: `module x { make(type _Duration, a) }; func c() { d = new(x._Duration); return *d }; c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunError: fmt.Errorf("cannot refer to private symbol '_Duration'")},
+		{Script: `module x { make(type _Duration, a); func c() { d = new(_Duration); return *d } }; x.c()`, Input: map[string]interface{}{"a": time.Duration(0)}, RunOutput: time.Duration(0)},
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
@@ -640,6 +822,55 @@ func TestChan(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestSelect(t *testing.T) {
+	tests := []Test{
+		{Script: `select { case 1: }`, ParseError: fmt.Errorf("select case must be receive or send"), RunError: fmt.Errorf("select case must be receive or send")},
+		{Script: `a = make(chan int64); select { case b = a: }`, ParseError: fmt.Errorf("select case must be receive"), RunError: fmt.Errorf("select case must be receive or send")},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b, c, d = <-a: }`, ParseError: fmt.Errorf("select case must assign one or two values")},
+		{Script: `select { default: 1; default: 2 }`, ParseError: fmt.Errorf("multiple default statement"), RunOutput: int64(2)},
+
+		{Script: `select { case <-1++: }`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `select { case <-1: }`, RunError: fmt.Errorf("invalid operation for chan")},
+		{Script: `a = make(chan bool, 1); select { case a <- 1: }`, RunError: fmt.Errorf("cannot use type int64 as type bool to send to chan")},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b = <-a: 1++ }`, RunError: fmt.Errorf("invalid operation")},
+
+		{Script: `select { default: }`, RunOutput: nil},
+		{Script: `select { default: 1 }`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64); select { case <-a: 1; default: 2 }`, RunOutput: int64(2)},
+		{Script: `a = make(chan int64, 1); select { case a <- 1: 1; default: 2 }; <-a`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64); select { case a <- 1: 1; default: 2 }`, RunOutput: int64(2)},
+
+		{Script: `a = make(chan int64, 1); a <- 1; select { case <-a: "a" }`, RunOutput: "a"},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b = <-a: b }`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b, c = <-a: [b, c] }`, RunOutput: []interface{}{int64(1), true}},
+		{Script: `a = make(chan int64, 1); close(a); select { case b, c = <-a: [b, c] }`, RunOutput: []interface{}{nil, false}},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b <- a: b }`, RunOutput: int64(1)},
+		{Script: `a = make(chan interface, 1); a <- "a"; select { case b = <-a: b }`, RunOutput: "a"},
+		{Script: `a = make(chan int64, 1); a <- 1; b = make(chan int64); select { case <-b: "b"; case c = <-a: c }`, RunOutput: int64(1)},
+
+		{Script: `a = make(chan int64); b = make(chan int64); go func() { b <- 2 }(); select { case c = <-a: c; case c = <-b: c }`, RunOutput: int64(2)},
+		{Script: `a = make(chan int64); go func() { <-a }(); select { case a <- 1: "sent" }`, RunOutput: "sent"},
+		{Script: `a = make(chan int64, 1); a <- 1; select {
+case b = <-a:
+	b + 1
+default:
+	0
+}`, RunOutput: int64(2)},
+
+		{Script: `a = make(chan int64, 1); a <- 1; b = 0; select { case b = <-a: }; b`, RunOutput: int64(1), Output: map[string]interface{}{"b": int64(1)}},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case b = <-a: var c = b }; c`, RunError: fmt.Errorf("undefined symbol 'c'")},
+		{Script: `a = make(chan int64, 1); a <- 1; for { select { case <-a: break } }; 1`, RunOutput: int64(1)},
+		{Script: `func f() { a = make(chan int64, 1); a <- 1; select { case b = <-a: return b } }; f()`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	tests = []Test{
+		// send on closed channel
+		{Script: `a = make(chan int64, 2); close(a); select { case a <- 1: }`, RunError: fmt.Errorf("send on closed channel")},
+	}
+	runTests(t, tests, nil, &Options{Debug: false})
+}
+
 func TestVMDelete(t *testing.T) {
 	tests := []Test{
 		{Script: `delete(1++)`, RunError: fmt.Errorf("invalid operation")},
@@ -921,6 +1152,36 @@ close(waitChan)
 try {
 	for { }
 } catch { }
+`,
+		`
+a = make(chan int64)
+close(waitChan)
+select {
+case <-a:
+}
+`,
+		`
+a = func(yield) {
+	for {
+		if !yield(1) {
+			return
+		}
+	}
+}
+b = 0
+close(waitChan)
+for v in a {
+	b = v
+}
+`,
+		`
+a = func(yield) {
+	yield(1)
+}
+close(waitChan)
+for v in a {
+	for { }
+}
 `,
 	}
 	for _, script := range scripts {
@@ -1052,6 +1313,246 @@ func TestContextFunction(t *testing.T) {
 	cancel()
 }
 
+func TestMaxSteps(t *testing.T) {
+	isStepLimit := func(t *testing.T, err error) {
+		if !errors.Is(err, ErrStepLimit) {
+			t.Errorf("Run error - received: %v - expected: %v", err, ErrStepLimit)
+		}
+	}
+	tests := []Test{
+		{Script: `a = 0; for i = 0; i < 10; i++ { a += i }; a`, RunOutput: int64(45)},
+		{Script: `for { }`, RunErrorFunc: &isStepLimit},
+		{Script: `func a() { return a() }; a()`, RunErrorFunc: &isStepLimit},
+		{Script: `func a() { for { } }; try { a() } catch { }; 1`, RunErrorFunc: &isStepLimit},
+		{Script: `try { for { } } catch (e) { } finally { return 1 }`, RunErrorFunc: &isStepLimit},
+		{Script: `c = make(chan bool); go func() { for { } ; c <- true }(); for { }`, RunErrorFunc: &isStepLimit},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, MaxSteps: 1000})
+
+	// script functions called by Go functions return errors by panic, which is only recovered when not in debug mode
+	tests = []Test{
+		{Script: `a(func() { for { } })`, Input: map[string]interface{}{"a": func(f func()) { f() }}, RunErrorFunc: &isStepLimit},
+	}
+	runTests(t, tests, nil, &Options{MaxSteps: 1000})
+
+	var steps int64
+	options := &Options{Steps: &steps}
+	_, err := Execute(env.NewEnv(), options, "a = 1\nb = a + 1")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if steps != 7 {
+		t.Errorf("Steps - received: %v - expected: %v", steps, 7)
+	}
+
+	options = &Options{MaxSteps: 5, Steps: &steps}
+	_, err = Execute(env.NewEnv(), options, "a = 1\nb = a + 1")
+	var vmError *Error
+	if !errors.As(err, &vmError) || !errors.Is(err, ErrStepLimit) {
+		t.Fatalf("Execute error - received: %#v - expected: %v", err, ErrStepLimit)
+	}
+	if vmError.Pos.Line != 2 {
+		t.Errorf("Execute error position - received: %v - expected line 2", vmError.Pos)
+	}
+	if steps != 5 {
+		t.Errorf("Steps - received: %v - expected: %v", steps, 5)
+	}
+
+	// functions defined in an earlier run count against the run calling them
+	e := env.NewEnv()
+	_, err = Execute(e, nil, "func a() { for { } }")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	_, err = Execute(e, &Options{MaxSteps: 100}, "a()")
+	if !errors.Is(err, ErrStepLimit) {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrStepLimit)
+	}
+}
+
+func TestQuotas(t *testing.T) {
+	isErr := func(target error) *func(t *testing.T, err error) {
+		f := func(t *testing.T, err error) {
+			if !errors.Is(err, target) {
+				t.Errorf("Run error - received: %v - expected: %v", err, target)
+			}
+		}
+		return &f
+	}
+	tests := []Test{
+		{Script: `len(make([]int64, 100))`, RunOutput: int64(100)},
+		{Script: `make([]int64, 101)`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `make([]int64, 0, 101)`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `make(chan int64, 101)`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = []; for i = 0; i < 100; i++ { a += i }; len(a)`, RunOutput: int64(100)},
+		{Script: `a = []; for { a += 1 }`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = make([]int64, 60); a += a`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = make([]int64, 60); [a..., a...]`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = make([]int64, 60); []int64{a..., a...}`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = {}; for i = 0; i < 60; i++ { a[i] = i }; len({...a, ...a, "x": 1})`, RunOutput: int64(61)},
+		{Script: `a = {}; b = {}; for i = 0; i < 60; i++ { a[i] = i; b[-i - 1] = i }; {...a, ...b}`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = {}; for { a[len(a)] = 1 }`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `a = []; for { a[len(a)] = 1 }`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `[x for x in make([]int64, 200)]`, RunErrorFunc: isErr(ErrContainerLengthLimit)},
+		{Script: `len("ab" * 50)`, RunOutput: int64(100)},
+		{Script: `"ab" * 51`, RunErrorFunc: isErr(ErrStringLengthLimit)},
+		{Script: `"ab" * 9223372036854775807`, RunErrorFunc: isErr(ErrStringLengthLimit)},
+		{Script: `a = ""; for { a += "x" }`, RunErrorFunc: isErr(ErrStringLengthLimit)},
+		{Script: `a = "x" * 60; a + a`, RunErrorFunc: isErr(ErrStringLengthLimit)},
+		{Script: `a = "x" * 60; "${a}${a}"`, RunErrorFunc: isErr(ErrStringLengthLimit)},
+		{Script: `try { "x" * 101 } catch { return 1 }`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, MaxContainerLength: 100, MaxStringLength: 100})
+
+	tests = []Test{
+		{Script: `make([]int64, 100)`, RunOutput: make([]int64, 100)},
+		{Script: `make([]int64, 200)`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `for i = 0; i < 100; i++ { make([]int64, 100) }`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `a = ""; for { a += "x" }`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `a = []; for { a += 1 }`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `try { for { a = "x" * 100 } } catch { }; 1`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `1n << 1000`, RunOutput: new(big.Int).Lsh(big.NewInt(1), 1000)},
+		{Script: `1n << 10000`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `a = 3n; for { a *= a }`, RunErrorFunc: isErr(ErrAllocLimit)},
+		{Script: `a = 1d / 3; for { a = a * a + 1 }`, RunErrorFunc: isErr(ErrAllocLimit)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, MaxAllocBytes: 1000})
+
+	var vmError *Error
+	_, err := Execute(env.NewEnv(), &Options{MaxStringLength: 10}, "a = 1\nb = \"x\" * 11")
+	if !errors.As(err, &vmError) || !errors.Is(err, ErrStringLengthLimit) {
+		t.Fatalf("Execute error - received: %#v - expected: %v", err, ErrStringLengthLimit)
+	}
+	if vmError.Pos.Line != 2 {
+		t.Errorf("Execute error position - received: %v - expected line 2", vmError.Pos)
+	}
+}
+
+func TestMaxCallDepth(t *testing.T) {
+	isStackOverflow := func(t *testing.T, err error) {
+		if !errors.Is(err, ErrStackOverflow) {
+			t.Errorf("Run error - received: %v - expected: %v", err, ErrStackOverflow)
+		}
+	}
+	tests := []Test{
+		{Script: `func a(n) { if n == 0 { return 0 }; return a(n - 1) + 1 }; a(99)`, RunOutput: int64(99)},
+		{Script: `func a(n) { if n == 0 { return 0 }; return a(n - 1) + 1 }; a(100)`, RunErrorFunc: &isStackOverflow},
+		{Script: `func a() { return a() }; a()`, RunErrorFunc: &isStackOverflow},
+		{Script: `func a() { b() }; func b() { a() }; a()`, RunErrorFunc: &isStackOverflow},
+		{Script: `func a() { a() }; try { a() } catch (e) { return e.Message }`, RunOutput: "stack overflow"},
+		{Script: `func a() { a() }; try { a() } catch { }; func b(n) { if n == 0 { return 0 }; return b(n - 1) + 1 }; b(99)`, RunOutput: int64(99)},
+		{Script: `func a(n) { if n == 0 { return 0 }; return a(n - 1) + 1 }; func b() { return a(50) }; [b(), b(), a(99)]`, RunOutput: []interface{}{int64(50), int64(50), int64(99)}},
+		{Script: `func a(n) { if n == 0 { return 0 }; return a(n - 1) + 1 }; func b(n) { if n == 0 { for i = 0; i < 10; i++ { go a(40) }; return 0 }; return b(n - 1) }; b(50); a(99)`, RunOutput: int64(99)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, MaxCallDepth: 100})
+
+	_, err := Execute(env.NewEnv(), nil, "func a() { a() }; a()")
+	if !errors.Is(err, ErrStackOverflow) {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrStackOverflow)
+	}
+
+	value, err := Execute(env.NewEnv(), &Options{MaxCallDepth: -1}, fmt.Sprintf("func a(n) { if n == 0 { return 0 }; return a(n - 1) + 1 }; a(%d)", DefaultMaxCallDepth+1))
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value != int64(DefaultMaxCallDepth+1) {
+		t.Errorf("Execute value - received: %v - expected: %v", value, DefaultMaxCallDepth+1)
+	}
+}
+
+func BenchmarkCallDepth(b *testing.B) {
+	for _, depth := range []int{0, 100, 1000} {
+		b.Run(fmt.Sprint(depth), func(b *testing.B) {
+			e := env.NewEnv()
+			_, err := Execute(e, nil, "func loop(depth, n) { if depth > 0 { return loop(depth - 1, n) }; for i = 0; i < n; i++ { } }")
+			if err != nil {
+				b.Fatal("Execute error:", err)
+			}
+
+			b.ResetTimer()
+			_, err = Execute(e, nil, fmt.Sprintf("loop(%d, %d)", depth, b.N))
+			if err != nil {
+				b.Fatal("Execute error:", err)
+			}
+		})
+	}
+}
+
+func TestGoroutines(t *testing.T) {
+	// waits for the goroutines to return
+	e := env.NewEnv()
+	var count int64
+	var mutex sync.Mutex
+	err := e.Define("add", func() { mutex.Lock(); count++; mutex.Unlock() })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, &Options{Goroutines: GoroutinesWait}, "for i = 0; i < 10; i++ { go func() { for j = 0; j < 1000; j++ { }; add() }() }")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if count != 10 {
+		t.Errorf("count - received: %v - expected: %v", count, 10)
+	}
+
+	// interrupts the goroutines and waits for them to return
+	_, err = Execute(e, &Options{Goroutines: GoroutinesCancel, GoroutineErrorHandler: func(err error) { t.Errorf("GoroutineErrorHandler error: %v", err) }},
+		"go func() { for { } }(); go func() { for { } }()")
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+
+	// errors and panics are recovered and passed to the handler
+	var errs []error
+	options := &Options{Goroutines: GoroutinesWait, GoroutineErrorHandler: func(err error) { mutex.Lock(); errs = append(errs, err); mutex.Unlock() }}
+	err = e.Define("panics", func() { panic("panic in goroutine") })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("fails", func() error { return io.EOF })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, options, "go panics()")
+	if err == nil || err.Error() != "panic in goroutine" {
+		t.Errorf("Execute error - received: %v - expected: %v", err, "panic in goroutine")
+	}
+	_, err = Execute(e, options, "go fails()")
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("Execute error - received: %v - expected: %v", err, io.EOF)
+	}
+	_, err = Execute(e, options, "go func() { throw \"thrown\" }()")
+	var vmError *Error
+	if !errors.As(err, &vmError) || vmError.Message != "thrown" {
+		t.Errorf("Execute error - received: %#v - expected: %v", err, "thrown")
+	}
+	_, err = Execute(e, options, "throw \"main\"; go fails()")
+	if err == nil || err.Error() != "main" {
+		t.Errorf("Execute error - received: %v - expected: %v", err, "main")
+	}
+	if len(errs) != 3 {
+		t.Errorf("GoroutineErrorHandler errors - received: %v - expected: %v", errs, 3)
+	}
+
+	// detached goroutines do not crash on panic
+	errs = nil
+	options.Goroutines = GoroutinesDetach
+	_, err = Execute(e, options, "c = make(chan bool); go func() { defer func() { c <- true }(); panics() }(); <-c")
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+
+	// limits the goroutines running at once
+	_, err = Execute(e, &Options{Goroutines: GoroutinesCancel, MaxGoroutines: 2}, "go func() { for { } }(); go func() { for { } }(); go func() { for { } }()")
+	if !errors.Is(err, ErrGoroutineLimit) {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrGoroutineLimit)
+	}
+	_, err = Execute(e, &Options{Goroutines: GoroutinesWait, MaxGoroutines: 2}, "for i = 0; i < 10; i++ { c = make(chan bool); go func() { c <- true }(); <-c }")
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+}
+
 func TestAssignToInterface(t *testing.T) {
 	e := env.NewEnv()
 	X := new(struct {
@@ -1169,3 +1670,104 @@ fib = func(x) {
 		}
 	}
 }
+
+func TestImportModules(t *testing.T) {
+	isFilename := func(filename string) func(t *testing.T, err error) {
+		return func(t *testing.T, err error) {
+			var received string
+			switch err := err.(type) {
+			case *Error:
+				received = err.Filename
+			case *parser.Error:
+				received = err.Filename
+			}
+			expected, _ := filepath.Abs(filepath.Join("testdata", "modules", filename))
+			expected, _ = filepath.EvalSymlinks(expected)
+			if received != expected {
+				t.Errorf("Run error filename - received: %v - expected: %v - error: %v", received, expected, err)
+			}
+		}
+	}
+	moduleErrorFilename := isFilename("error.ank")
+	brokenFilename := isFilename("broken.ank")
+	utilFilename, _ := filepath.Abs(filepath.Join("testdata", "modules", "util.ank"))
+	isImportCycle := func(t *testing.T, err error) {
+		if err == nil || !strings.HasPrefix(err.Error(), "import cycle: ") || !strings.HasSuffix(err.Error(), "cycleA.ank") {
+			t.Errorf("Run error - received: %v - expected: import cycle", err)
+		}
+	}
+
+	tests := []Test{
+		{Script: `util = import "util.ank"; util.double(3)`, RunOutput: int64(6)},
+		{Script: `util = import("./util.ank"); util.name`, RunOutput: "util"},
+		{Script: `helper = import "lib/helper.ank"; helper.twice(2)`, RunOutput: int64(4)},
+		{Script: `a = import "counter.ank"; b = import "./counter.ank"; a.increment(); b.increment()`, RunOutput: int64(2)},
+		{Script: `count = 10; a = import "counter.ank"; a.increment(); [count, a.count]`, RunOutput: []interface{}{int64(10), int64(1)}},
+		{Script: `name = "main"; util = import "util.ank"; name`, RunOutput: "main"},
+		{Script: `func f() { return import "counter.ank" }; a = f(); b = f(); a.increment(); b.increment()`, RunOutput: int64(2)},
+		{Script: `a = import "private.ank"; a.reveal()`, RunOutput: "secret"},
+		{Script: `a = import "private.ank"; a._secret`, RunError: fmt.Errorf("cannot refer to private symbol '_secret'")},
+
+		{Script: `import "a.ank"`, RunError: fmt.Errorf("module not found: a.ank")},
+		{Script: `import "lib"`, RunError: fmt.Errorf("package not found: lib")},
+		{Script: `import "error.ank"`, RunErrorFunc: &moduleErrorFilename},
+		{Script: `import "broken.ank"`, RunErrorFunc: &brokenFilename},
+		{Script: `import "cycleA.ank"`, RunErrorFunc: &isImportCycle},
+		{Script: `import "../outside.ank"`, RunError: fmt.Errorf("module not allowed: ../outside.ank")},
+		{Script: `import "lib/../../outside.ank"`, RunError: fmt.Errorf("module not allowed: lib/../../outside.ank")},
+		{Script: `import "lib/escape.ank"`, RunError: fmt.Errorf("module not allowed: ../../outside.ank")},
+		{Script: `import(a)`, Input: map[string]interface{}{"a": utilFilename}, RunError: errors.New("module not allowed: " + utilFilename)},
+	}
+	for _, test := range tests {
+		runTest(t, test, nil, &Options{Debug: true, ModuleLoader: NewModuleLoader(filepath.Join("testdata", "modules"))})
+	}
+
+	tests = []Test{
+		{Script: `import "util.ank"`, RunError: fmt.Errorf("module not found: util.ank")},
+		{Script: `import "./util.ank"`, RunError: fmt.Errorf("module not found: ./util.ank")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// an Env with a PackageRegistry only imports modules if it allows them, the modules use the same PackageRegistry
+	registry := env.NewGlobalPackageRegistry()
+	registry.DenyPackages("os")
+	setRegistry := func(t *testing.T, e *env.Env) { e.SetPackageRegistry(registry) }
+	tests = []Test{
+		{Script: `import "util.ank"`, RunError: fmt.Errorf("module not allowed: util.ank")},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &setRegistry}, &Options{Debug: true, ModuleLoader: NewModuleLoader(filepath.Join("testdata", "modules"))})
+	registry.AllowModules()
+	tests = []Test{
+		{Script: `util = import "util.ank"; util.double(3)`, RunOutput: int64(6)},
+		{Script: `import "os.ank"`, RunError: fmt.Errorf("package not allowed: os")},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &setRegistry}, &Options{Debug: true, ModuleLoader: NewModuleLoader(filepath.Join("testdata", "modules"))})
+	scope := env.NewEnv().NewEnv()
+	scope.SetPackageRegistry(registry)
+	_, err := Execute(scope, &Options{ModuleLoader: NewModuleLoader(filepath.Join("testdata", "modules"))}, `import "os.ank"`)
+	if err == nil || err.Error() != "package not allowed: os" {
+		t.Errorf("Execute error - received: %v - expected: %v", err, "package not allowed: os")
+	}
+
+	// a module that fails because of the run, like a limit, is imported again by the next run
+	loader := NewModuleLoader(filepath.Join("testdata", "modules"))
+	_, err = Execute(env.NewEnv(), &Options{ModuleLoader: loader, MaxSteps: 20}, `import "slow.ank"`)
+	if !errors.Is(err, ErrStepLimit) {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrStepLimit)
+	}
+	value, err := Execute(env.NewEnv(), &Options{ModuleLoader: loader}, `slow = import "slow.ank"; slow.total`)
+	if err != nil || value != int64(4950) {
+		t.Errorf("Execute - received: %v, %v - expected: %v", value, err, int64(4950))
+	}
+
+	dir := t.TempDir()
+	outsideFilename, _ := filepath.Abs(filepath.Join("testdata", "outside.ank"))
+	err = os.Symlink(outsideFilename, filepath.Join(dir, "link.ank"))
+	if err != nil {
+		t.Skip("Symlink error:", err)
+	}
+	tests = []Test{
+		{Script: `import "link.ank"`, RunError: fmt.Errorf("module not allowed: link.ank")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, ModuleLoader: NewModuleLoader(dir)})
+}